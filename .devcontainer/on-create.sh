@@ -49,13 +49,6 @@ curl -fsSL -o - "https://github.com/evilmartians/lefthook/releases/download/${LE
     gzip -c -d > ~/.local/bin/lefthook
 chmod +x ~/.local/bin/lefthook
 
-# Install yq
-YQ_URL="https://api.github.com/repos/mikefarah/yq/releases?per_page=1"
-YQ_VERSION=$(curl -fsSL -H "${GITHUB_HEADER_ACCEPT}" -H "${GITHUB_HEADER_VERSION}" "${YQ_URL}" | jq -r '.[0].tag_name')
-curl -fsSL -o - "https://github.com/mikefarah/yq/releases/download/${YQ_VERSION}/yq_linux_amd64.tar.gz" | \
-    tar -zxf - -O "./yq_linux_amd64" > ~/.local/bin/yq
-chmod +x ~/.local/bin/yq
-
 # Install mcpcurl
 mkdir -p ~/.local/bin
 pushd /tmp
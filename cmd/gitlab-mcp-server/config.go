@@ -0,0 +1,277 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+// profile is the settings of a GitLab server in the config file.
+type profile struct {
+	Url string `mapstructure:"url"`
+	// Token is the token itself. TokenEnv or TokenFile is recommended not to write the token in the config file.
+	Token          string     `mapstructure:"token"`
+	TokenEnv       string     `mapstructure:"token-env"`
+	TokenFile      string     `mapstructure:"token-file"`
+	Readonly       *bool      `mapstructure:"readonly"`
+	Tools          []string   `mapstructure:"tools"`
+	DefaultProject string     `mapstructure:"default-project"`
+	TLS            tlsProfile `mapstructure:"tls"`
+}
+
+type tlsProfile struct {
+	CaFile             string `mapstructure:"ca-file"`
+	CertFile           string `mapstructure:"cert-file"`
+	KeyFile            string `mapstructure:"key-file"`
+	InsecureSkipVerify bool   `mapstructure:"insecure-skip-verify"`
+}
+
+// token returns the token from the first source specified in the profile.
+func (p *profile) token() (string, error) {
+	switch {
+	case p.Token != "":
+		return p.Token, nil
+	case p.TokenEnv != "":
+		token := os.Getenv(p.TokenEnv)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s is empty", p.TokenEnv)
+		}
+
+		return token, nil
+	case p.TokenFile != "":
+		content, err := os.ReadFile(p.TokenFile)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(content)), nil
+	default:
+		return "", nil
+	}
+}
+
+// defaultConfigFile returns the path of the config file in the XDG config directory
+// without the extension, which is one of the extensions supported by viper such as '.yaml' or '.toml'.
+func defaultConfigFile() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "gitlab-mcp-server", "config"), nil
+}
+
+// readConfig reads the config file specified by '--config' or in the XDG config directory.
+// It returns nil if '--config' is not specified and the file does not exist.
+func readConfig(v *viper.Viper) (*viper.Viper, error) {
+	config := viper.New()
+	if name := v.GetString("config"); name != "" {
+		config.SetConfigFile(name)
+	} else {
+		name, err := defaultConfigFile()
+		if err != nil {
+			return nil, nil
+		}
+
+		config.AddConfigPath(filepath.Dir(name))
+		config.SetConfigName(filepath.Base(name))
+	}
+
+	if err := config.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// loadProfile applies the profile selected by '--profile' or 'profile' in the config file,
+// and returns the config file, or nil if it does not exist. The settings of the profile are
+// applied as the defaults, so that the arguments and the environment variables take precedence.
+func loadProfile(v *viper.Viper) (*viper.Viper, error) {
+	config, err := readConfig(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if config != nil && config.IsSet("instances") {
+		v.SetDefault("instances", config.GetStringSlice("instances"))
+	}
+
+	name := v.GetString("profile")
+	if name == "" && config != nil {
+		name = config.GetString("profile")
+	}
+
+	if name == "" {
+		return config, nil
+	}
+
+	p, err := readProfile(config, name)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.token()
+	if err != nil {
+		return nil, fmt.Errorf("profile %s: %w", name, err)
+	}
+
+	setDefault(v, "url", p.Url)
+	setDefault(v, "token", token)
+	setDefault(v, "default-project", p.DefaultProject)
+	setDefault(v, "tls-ca-file", p.TLS.CaFile)
+	setDefault(v, "tls-cert-file", p.TLS.CertFile)
+	setDefault(v, "tls-key-file", p.TLS.KeyFile)
+
+	if p.Readonly != nil {
+		v.SetDefault("readonly", *p.Readonly)
+	}
+
+	if len(p.Tools) != 0 {
+		v.SetDefault("tools", p.Tools)
+	}
+
+	if p.TLS.InsecureSkipVerify {
+		v.SetDefault("tls-insecure-skip-verify", true)
+	}
+
+	return config, nil
+}
+
+// readProfile returns the profile in the config file.
+func readProfile(config *viper.Viper, name string) (*profile, error) {
+	key := "profiles." + name
+	if config == nil || !config.IsSet(key) {
+		return nil, fmt.Errorf("unknown profile: %s", name)
+	}
+
+	var p profile
+	if err := config.UnmarshalKey(key, &p); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", name, err)
+	}
+
+	return &p, nil
+}
+
+// loadInstances returns the GitLab instances of the profiles specified by '--instances'.
+// The instance is readonly unless 'readonly' of the profile is false.
+// The instance without the TLS settings uses the default transport instead of the TLS settings
+// of the arguments.
+func loadInstances(v *viper.Viper, config *viper.Viper) (map[string]gitlab.Instance, error) {
+	instances := map[string]gitlab.Instance{}
+	for _, name := range v.GetStringSlice("instances") {
+		p, err := readProfile(config, name)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := p.token()
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+
+		transport, err := p.TLS.transport()
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+
+		instances[name] = gitlab.Instance{
+			Url:       p.Url,
+			Token:     token,
+			Readonly:  p.Readonly == nil || *p.Readonly,
+			Transport: transport,
+		}
+	}
+
+	return instances, nil
+}
+
+func setDefault(v *viper.Viper, key string, value string) {
+	if value != "" {
+		v.SetDefault(key, value)
+	}
+}
+
+// newTLSTransport returns the transport with the TLS settings of the arguments, or nil if none of them is specified.
+func newTLSTransport(v *viper.Viper) (http.RoundTripper, error) {
+	t := tlsProfile{
+		CaFile:             v.GetString("tls-ca-file"),
+		CertFile:           v.GetString("tls-cert-file"),
+		KeyFile:            v.GetString("tls-key-file"),
+		InsecureSkipVerify: v.GetBool("tls-insecure-skip-verify"),
+	}
+
+	return t.transport()
+}
+
+// transport returns the transport with the CA certificates, the client certificate and
+// the verification of the server certificate, or nil if none of them is specified.
+func (t *tlsProfile) transport() (http.RoundTripper, error) {
+	if t.CaFile == "" && t.CertFile == "" && t.KeyFile == "" && !t.InsecureSkipVerify {
+		return nil, nil
+	}
+
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("unexpected default transport")
+	}
+
+	transport := base.Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The verification is disabled only if the user specifies it explicitly.
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CaFile != "" {
+		content, err := os.ReadFile(t.CaFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no certificates in %s", t.CaFile)
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const testConfig = `
+profile: gitlab-com
+profiles:
+  gitlab-com:
+    url: https://gitlab.com
+    token-env: TEST_GITLAB_COM_TOKEN
+    tools: ["get_*"]
+  internal:
+    url: https://gitlab.example.com
+    token-file: %TOKEN_FILE%
+    readonly: false
+    default-project: group/project
+    tls:
+      insecure-skip-verify: true
+`
+
+// newTestViper returns the viper bound to the flags as main does, and the config file in the XDG config directory.
+func newTestViper(t *testing.T, args ...string) *viper.Viper {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("TEST_GITLAB_COM_TOKEN", "gitlab-com-token")
+
+	tokenFile := filepath.Join(dir, "internal.token")
+	if err := os.WriteFile(tokenFile, []byte("internal-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte(strings.ReplaceAll(testConfig, "%TOKEN_FILE%", tokenFile))
+	if err := os.MkdirAll(filepath.Join(dir, "gitlab-mcp-server"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "gitlab-mcp-server", "config.yaml"), content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("config", "", "")
+	flags.String("profile", "", "")
+	flags.String("url", "https://127.0.0.1", "")
+	flags.String("token", "", "")
+	flags.Bool("readonly", true, "")
+	flags.StringSlice("tools", nil, "")
+	flags.StringSlice("instances", nil, "")
+	flags.Bool("tls-insecure-skip-verify", false, "")
+	if err := flags.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+
+	v := viper.New()
+	if err := v.BindPFlags(flags); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func TestLoadProfile(t *testing.T) {
+	v := newTestViper(t)
+	if _, err := loadProfile(v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.GetString("url") != "https://gitlab.com" || v.GetString("token") != "gitlab-com-token" || !v.GetBool("readonly") {
+		t.Errorf("unexpected settings: %v", v.AllSettings())
+	}
+
+	if tools := v.GetStringSlice("tools"); !reflect.DeepEqual(tools, []string{"get_*"}) {
+		t.Errorf("unexpected tools: %v", tools)
+	}
+}
+
+func TestLoadProfileSelected(t *testing.T) {
+	v := newTestViper(t, "--profile=internal", "--url=https://gitlab.test")
+	if _, err := loadProfile(v); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]any{
+		"url":                      "https://gitlab.test",
+		"token":                    "internal-token",
+		"readonly":                 false,
+		"default-project":          "group/project",
+		"tls-insecure-skip-verify": true,
+	}
+
+	for key, value := range expected {
+		if v.Get(key) != value {
+			t.Errorf("%s: got %v, want %v", key, v.Get(key), value)
+		}
+	}
+}
+
+func TestLoadInstances(t *testing.T) {
+	// The TLS settings of the arguments are not applied to the instances.
+	v := newTestViper(t, "--instances=gitlab-com,internal", "--tls-insecure-skip-verify")
+	config, err := loadProfile(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instances, err := loadInstances(v, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if i := instances["gitlab-com"]; i.Url != "https://gitlab.com" || i.Token != "gitlab-com-token" || !i.Readonly || i.Transport != http.DefaultTransport {
+		t.Errorf("unexpected instance: %+v", i)
+	}
+
+	if i := instances["internal"]; i.Url != "https://gitlab.example.com" || i.Token != "internal-token" || i.Readonly || i.Transport == nil || i.Transport == http.DefaultTransport {
+		t.Errorf("unexpected instance: %+v", i)
+	}
+
+	if _, err := loadInstances(newTestViper(t, "--instances=missing"), config); err == nil {
+		t.Error("missing: no error")
+	}
+}
+
+func TestLoadProfileError(t *testing.T) {
+	for _, args := range [][]string{
+		{"--profile=missing"},
+		{"--config=" + filepath.Join(t.TempDir(), "missing.yaml")},
+	} {
+		if _, err := loadProfile(newTestViper(t, args...)); err == nil {
+			t.Errorf("%v: no error", args)
+		}
+	}
+}
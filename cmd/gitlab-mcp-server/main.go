@@ -5,10 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
 
 	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
 )
@@ -16,10 +28,127 @@ import (
 var version = "<version>"
 var commit = "<commit>"
 
-func fromArgument(ctx context.Context) context.Context {
-	ctx = context.WithValue(ctx, gitlab.UrlKey{}, viper.GetString("url"))
-	ctx = context.WithValue(ctx, gitlab.TokenKey{}, viper.GetString("token"))
-	return ctx
+func fromArgument(transport http.RoundTripper) server.StdioContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, gitlab.UrlKey{}, viper.GetString("url"))
+		ctx = context.WithValue(ctx, gitlab.TokenKey{}, viper.GetString("token"))
+		ctx = context.WithValue(ctx, gitlab.UploadDirKey{}, viper.GetString("upload-dir"))
+		ctx = context.WithValue(ctx, gitlab.TransportKey{}, transport)
+		return ctx
+	}
+}
+
+// newTransport returns the transport to record or replay the traffic, the transport with
+// the TLS settings, or nil to send the requests as usual.
+func newTransport() (http.RoundTripper, error) {
+	base, err := newTLSTransport(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+
+	record := viper.GetString("record")
+	replay := viper.GetString("replay")
+	switch {
+	case record != "" && replay != "":
+		return nil, errors.New("--record and --replay can not be specified at the same time")
+	case record != "":
+		return gitlab.NewRecordTransport(record, base)
+	case replay != "":
+		return gitlab.NewReplayTransport(replay)
+	default:
+		return base, nil
+	}
+}
+
+// newLogger returns the logger writing to the log file or stderr. The log is never
+// written to stdout because it is used by the stdio transport.
+func newLogger() (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(viper.GetString("log-level"))); err != nil {
+		return nil, err
+	}
+
+	format := viper.GetString("log-format")
+	if format != "text" && format != "json" {
+		return nil, fmt.Errorf("unknown log format: %s", format)
+	}
+
+	out := os.Stderr
+	if name := viper.GetString("log-file"); name != "" {
+		file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+
+		if sameFile(file, os.Stdout) {
+			_ = file.Close()
+			return nil, errors.New("--log-file can not be stdout")
+		}
+
+		out = file
+	}
+
+	options := &slog.HandlerOptions{Level: level}
+	if format == "json" {
+		return slog.New(slog.NewJSONHandler(out, options)), nil
+	}
+
+	return slog.New(slog.NewTextHandler(out, options)), nil
+}
+
+func sameFile(a *os.File, b *os.File) bool {
+	aInfo, err := a.Stat()
+	if err != nil {
+		return false
+	}
+
+	bInfo, err := b.Stat()
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(aInfo, bInfo)
+}
+
+// serveMetrics serves the metrics at '/metrics' on the address in background.
+func serveMetrics(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", gitlab.MetricsHandler())
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := srv.Serve(listener); err != nil {
+			slog.Error("metrics server stopped", slog.String("error", err.Error()))
+		}
+	}()
+
+	slog.Info("serving metrics", slog.String("addr", listener.Addr().String()))
+	return nil
+}
+
+// newTracerProvider returns the tracer provider exporting the spans to the OTLP/HTTP endpoint.
+// The exporter is configured by the OTEL_EXPORTER_OTLP_* environment variables too, such as
+// OTEL_EXPORTER_OTLP_HEADERS.
+func newTracerProvider(ctx context.Context, endpoint string) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("gitlab-mcp-server"),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res)), nil
 }
 
 var rootCmd = &cobra.Command{
@@ -28,15 +157,116 @@ var rootCmd = &cobra.Command{
 	Long:    "GitLab MCP Server",
 	Version: fmt.Sprintf("%s\nCommit: %s", version, commit),
 	Run: func(cmd *cobra.Command, args []string) {
-		s := server.NewMCPServer(
-			"GitLab MCP Server",
-			"0.1.0",
+		config, err := loadProfile(viper.GetViper())
+		if err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
+
+		logger, err := newLogger()
+		if err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
+
+		slog.SetDefault(logger)
+
+		options := []server.ServerOption{
 			server.WithToolCapabilities(false),
-		)
+			server.WithToolHandlerMiddleware(gitlab.LoggingMiddleware),
+		}
+
+		if project := viper.GetString("default-project"); project != "" {
+			options = append(options, server.WithInstructions(fmt.Sprintf(
+				"The default GitLab project is '%s'. Use it as the 'id' argument of the tools for a project if the project is not specified.",
+				project,
+			)))
+		}
+
+		if addr := viper.GetString("metrics-addr"); addr != "" {
+			if err := serveMetrics(addr); err != nil {
+				//revive:disable:deep-exit
+				log.Fatalf("Server error: %v", err)
+				//revive:enable:deep-exit
+			}
+
+			options = append(options, server.WithToolHandlerMiddleware(gitlab.MetricsMiddleware))
+		}
+
+		if name := viper.GetString("audit-log"); name != "" {
+			audit, err := gitlab.NewAuditLog(name, viper.GetInt64("audit-log-max-size")*1024*1024)
+			if err != nil {
+				//revive:disable:deep-exit
+				log.Fatalf("Server error: %v", err)
+				//revive:enable:deep-exit
+			}
+
+			defer audit.Close()
+
+			options = append(options, server.WithToolHandlerMiddleware(audit.Middleware))
+		}
+
+		if endpoint := viper.GetString("otlp-endpoint"); endpoint != "" {
+			provider, err := newTracerProvider(cmd.Context(), endpoint)
+			if err != nil {
+				//revive:disable:deep-exit
+				log.Fatalf("Server error: %v", err)
+				//revive:enable:deep-exit
+			}
+
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := provider.Shutdown(ctx); err != nil {
+					slog.Error("failed to export traces", slog.String("error", err.Error()))
+				}
+			}()
+
+			otel.SetTracerProvider(provider)
+			otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+			options = append(options, server.WithToolHandlerMiddleware(gitlab.TracingMiddleware))
+		}
+
+		s := server.NewMCPServer("GitLab MCP Server", "0.1.0", options...)
 
 		gitlab.RegisterTools(s, viper.GetBool("readonly"))
+		if err := gitlab.FilterTools(s, viper.GetStringSlice("tools")); err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
+
+		instances, err := loadInstances(viper.GetViper(), config)
+		if err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
+
+		if viper.GetString("record") != "" || viper.GetString("replay") != "" {
+			// The traffic to all instances is recorded or replayed by the transport in the context.
+			for name, instance := range instances {
+				instance.Transport = nil
+				instances[name] = instance
+			}
+		}
+
+		if err := gitlab.RegisterInstances(s, instances); err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
+
+		transport, err := newTransport()
+		if err != nil {
+			//revive:disable:deep-exit
+			log.Fatalf("Server error: %v", err)
+			//revive:enable:deep-exit
+		}
 
-		if err := server.ServeStdio(s, server.WithStdioContextFunc(fromArgument)); err != nil {
+		if err := server.ServeStdio(s, server.WithStdioContextFunc(fromArgument(transport))); err != nil {
 			if !errors.Is(err, context.Canceled) {
 				//revive:disable:deep-exit
 				log.Fatalf("Server error: %v", err)
@@ -49,17 +279,56 @@ var rootCmd = &cobra.Command{
 func init() {
 	cobra.OnInitialize(initConfig)
 
+	rootCmd.PersistentFlags().String("config", "", "Config file. '$XDG_CONFIG_HOME/gitlab-mcp-server/config.{yaml,toml}' is read if not specified.")
+	rootCmd.PersistentFlags().String("profile", "", "Profile in the config file.")
+	rootCmd.PersistentFlags().StringSlice("instances", nil, "Profiles in the config file selected by the 'instance' argument of the tools.")
 	rootCmd.PersistentFlags().String("url", "https://127.0.0.1", "GitLab server URL.")
 	rootCmd.PersistentFlags().String("token", "", "GitLab server token.")
 	rootCmd.PersistentFlags().Bool("readonly", true, "HTTP GET method only.")
+	rootCmd.PersistentFlags().StringSlice("tools", nil, "Patterns of the tool names to register such as 'get_*'. All tools are registered if not specified.")
+	rootCmd.PersistentFlags().String("default-project", "", "Project used if the project is not specified, such as 'group/project'.")
+	rootCmd.PersistentFlags().String("tls-ca-file", "", "CA certificates file to verify GitLab server.")
+	rootCmd.PersistentFlags().String("tls-cert-file", "", "Client certificate file.")
+	rootCmd.PersistentFlags().String("tls-key-file", "", "Client private key file.")
+	rootCmd.PersistentFlags().Bool("tls-insecure-skip-verify", false, "Skip the verification of GitLab server certificate.")
+	rootCmd.PersistentFlags().String("upload-dir", "", "Directory of local files allowed to upload.")
+	rootCmd.PersistentFlags().String("record", "", "Directory to record HTTP traffic as cassette files.")
+	rootCmd.PersistentFlags().String("replay", "", "Directory to replay HTTP traffic from cassette files.")
+	rootCmd.PersistentFlags().String("log-level", "info", "Log level (debug, info, warn or error).")
+	rootCmd.PersistentFlags().String("log-format", "text", "Log format (text or json).")
+	rootCmd.PersistentFlags().String("log-file", "", "Log file. The log is written to stderr if not specified.")
+	rootCmd.PersistentFlags().String("metrics-addr", "", "Address to serve Prometheus metrics at /metrics such as ':9090'.")
+	rootCmd.PersistentFlags().String("audit-log", "", "Audit log file of the requests changing GitLab resources in JSON Lines.")
+	rootCmd.PersistentFlags().Int64("audit-log-max-size", 100, "Maximum size in megabytes of the audit log file before it is rotated.")
+	rootCmd.PersistentFlags().String("otlp-endpoint", "", "OTLP/HTTP endpoint to export traces such as 'http://localhost:4318/v1/traces'.")
 
+	viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
+	viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+	viper.BindPFlag("instances", rootCmd.PersistentFlags().Lookup("instances"))
 	viper.BindPFlag("url", rootCmd.PersistentFlags().Lookup("url"))
 	viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("token"))
 	viper.BindPFlag("readonly", rootCmd.PersistentFlags().Lookup("readonly"))
+	viper.BindPFlag("tools", rootCmd.PersistentFlags().Lookup("tools"))
+	viper.BindPFlag("default-project", rootCmd.PersistentFlags().Lookup("default-project"))
+	viper.BindPFlag("tls-ca-file", rootCmd.PersistentFlags().Lookup("tls-ca-file"))
+	viper.BindPFlag("tls-cert-file", rootCmd.PersistentFlags().Lookup("tls-cert-file"))
+	viper.BindPFlag("tls-key-file", rootCmd.PersistentFlags().Lookup("tls-key-file"))
+	viper.BindPFlag("tls-insecure-skip-verify", rootCmd.PersistentFlags().Lookup("tls-insecure-skip-verify"))
+	viper.BindPFlag("upload-dir", rootCmd.PersistentFlags().Lookup("upload-dir"))
+	viper.BindPFlag("record", rootCmd.PersistentFlags().Lookup("record"))
+	viper.BindPFlag("replay", rootCmd.PersistentFlags().Lookup("replay"))
+	viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
+	viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
+	viper.BindPFlag("metrics-addr", rootCmd.PersistentFlags().Lookup("metrics-addr"))
+	viper.BindPFlag("audit-log", rootCmd.PersistentFlags().Lookup("audit-log"))
+	viper.BindPFlag("audit-log-max-size", rootCmd.PersistentFlags().Lookup("audit-log-max-size"))
+	viper.BindPFlag("otlp-endpoint", rootCmd.PersistentFlags().Lookup("otlp-endpoint"))
 }
 
 func initConfig() {
 	viper.SetEnvPrefix("gitlab")
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
 }
 
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// constraint is the composite literal of jsonschema.Schema carrying the constraints of a property.
+type constraint struct {
+	Name    string
+	Literal string
+}
+
+// constraints are the constraints of the properties of the field such as 'params' in the request.
+// These are merged into the schema reflected from the type of gitlab-client-go, which has no enum,
+// default, minimum, maximum nor format.
+type constraints struct {
+	Field      string
+	Properties []constraint
+}
+
+// format returns the format of the string. The formats of the numbers such as int32 are
+// expressed by the Go types, and the binary is expressed by FileContent.
+func (sc schema) format() string {
+	if sc.Type != "string" || sc.Format == "binary" {
+		return ""
+	}
+
+	return sc.Format
+}
+
+// constraintTag returns the options of the jsonschema struct tag such as ',enum=opened,enum=closed'.
+// The enum and the format of the array are applied to the items.
+// The values which can not be written in the struct tag are omitted.
+func constraintTag(sc schema) string {
+	values := sc
+	if sc.Type == "array" && sc.Items != nil {
+		values = *sc.Items
+	}
+
+	options := []string{}
+	for _, v := range values.Enum {
+		options = append(options, "enum="+fmt.Sprint(v))
+	}
+
+	if f := values.format(); f != "" {
+		options = append(options, "format="+f)
+	}
+
+	if list, ok := sc.Default.([]any); ok {
+		for _, v := range list {
+			options = append(options, "default="+fmt.Sprint(v))
+		}
+	} else if sc.Default != nil {
+		options = append(options, "default="+fmt.Sprint(sc.Default))
+	}
+
+	if sc.Type != "array" && sc.Minimum != nil {
+		options = append(options, "minimum="+number(*sc.Minimum))
+	}
+
+	if sc.Type != "array" && sc.Maximum != nil {
+		options = append(options, "maximum="+number(*sc.Maximum))
+	}
+
+	tag := ""
+	for _, option := range options {
+		_, value, _ := strings.Cut(option, "=")
+		if strings.ContainsAny(value, ",=\"`\\") {
+			continue
+		}
+
+		tag += "," + option
+	}
+
+	return tag
+}
+
+// constraintLiteral returns the composite literal of jsonschema.Schema such as
+// '{Enum: []any{"opened", "closed"}}', or an empty string if the schema has no constraints.
+func constraintLiteral(sc schema) string {
+	fields := []string{}
+	if enum, ok := goLiteral(sc.Enum); ok && len(sc.Enum) != 0 {
+		fields = append(fields, "Enum: "+enum)
+	}
+
+	if value, ok := goLiteral(sc.Default); ok && sc.Default != nil {
+		fields = append(fields, "Default: "+value)
+	}
+
+	if sc.Minimum != nil {
+		fields = append(fields, "Minimum: "+strconv.Quote(number(*sc.Minimum)))
+	}
+
+	if sc.Maximum != nil {
+		fields = append(fields, "Maximum: "+strconv.Quote(number(*sc.Maximum)))
+	}
+
+	if f := sc.format(); f != "" {
+		fields = append(fields, "Format: "+strconv.Quote(f))
+	}
+
+	if sc.Type == "array" && sc.Items != nil {
+		if items := constraintLiteral(*sc.Items); items != "" {
+			fields = append(fields, "Items: &jsonschema.Schema"+items)
+		}
+	}
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// goLiteral returns the Go literal of the value decoded from YAML.
+// It returns false if the value such as an object can not be written.
+func goLiteral(v any) (string, bool) {
+	switch value := v.(type) {
+	case string:
+		return strconv.Quote(value), true
+	case bool, int:
+		return fmt.Sprint(value), true
+	case float64:
+		return number(value), true
+	case []any:
+		items := []string{}
+		for _, item := range value {
+			literal, ok := goLiteral(item)
+			if !ok {
+				return "", false
+			}
+
+			items = append(items, literal)
+		}
+
+		return "[]any{" + strings.Join(items, ", ") + "}", true
+	default:
+		return "", false
+	}
+}
+
+func number(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// constraints returns the constraints of the query parameters and the JSON request body.
+func (s *spec) constraints(e *endpoint, hasBody bool) ([]constraints, error) {
+	all := []constraints{}
+
+	params := constraints{Field: "params"}
+	for _, p := range e.Op.Parameters {
+		if p.In != "query" {
+			continue
+		}
+
+		sc, err := s.resolve(p.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if literal := constraintLiteral(sc); literal != "" {
+			params.Properties = append(params.Properties, constraint{Name: p.Name, Literal: literal})
+		}
+	}
+
+	if len(params.Properties) != 0 {
+		all = append(all, params)
+	}
+
+	if !hasBody {
+		return all, nil
+	}
+
+	sc, err := s.formSchema(e.Op)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := sc.properties()
+	if err != nil {
+		return nil, err
+	}
+
+	body := constraints{Field: "body"}
+	for _, p := range properties {
+		resolved, err := s.resolve(p.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if literal := constraintLiteral(resolved); literal != "" {
+			body.Properties = append(body.Properties, constraint{Name: p.Name, Literal: literal})
+		}
+	}
+
+	if len(body.Properties) != 0 {
+		all = append(all, body)
+	}
+
+	return all, nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files.")
+
+func TestGenerateGolden(t *testing.T) {
+	s, err := loadSpec(filepath.Join("testdata", "openapi.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := generate(s, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "golden")
+	if *update {
+		if err := os.RemoveAll(golden); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.MkdirAll(golden, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, entry := range entries {
+		actual, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name := filepath.Join(golden, entry.Name()+".golden")
+		if *update {
+			if err := os.WriteFile(name, actual, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		expected, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("%v: run 'go test ./internal/gen -update'", err)
+		}
+
+		if string(actual) != string(expected) {
+			t.Errorf("%s differs from %s: run 'go test ./internal/gen -update'", entry.Name(), name)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(golden, "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != len(entries) {
+		t.Errorf("%d golden files, but %d files generated", len(files), len(entries))
+	}
+}
+
+func TestGenerateRemovesStaleFiles(t *testing.T) {
+	s, err := loadSpec(filepath.Join("testdata", "openapi.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		// The file of the group removed from the specification.
+		"removed.go": generatedHeader + "\n\npackage gitlab\n",
+		// The hand-written file.
+		"http.go": "package gitlab\n",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := generate(s, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "removed.go")); !os.IsNotExist(err) {
+		t.Errorf("removed.go is not removed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "http.go")); err != nil {
+		t.Errorf("http.go is removed: %v", err)
+	}
+}
+
+func TestAssignToolNames(t *testing.T) {
+	conan := "/api/v4/projects/{id}/packages/conan/%s/conans/{package_name}/{package_version}/{package_username}/{package_channel}"
+	endpoints := []*endpoint{
+		{Method: "Get", Path: "/api/v4/projects/{id}/pipeline_schedules/{pipeline_schedule_id}"},
+		{Method: "Get", Path: "/api/v4/projects/{id}/pipeline_schedules"},
+		{Method: "Delete", Path: "/api/v4/projects/{id}/alert_management_alerts/{alert_iid}/metric_images/{metric_image_id}"},
+		{Method: "Post", Path: "/api/v4/projects/{id}/alert_management_alerts/{alert_iid}/metric_images/authorize"},
+		{Method: "Post", Path: "/api/v4/projects/{id}/clusters/agents/{agent_id}/tokens/{token_id}/revoke"},
+		{Method: "Get", Path: "/api/v4/groups/{id}/descendant_groups"},
+		{Method: "Post", Path: fmt.Sprintf(conan, "v1") + "/packages/{conan_package_reference}/upload_urls"},
+		{Method: "Post", Path: "/api/v4/packages/conan/v1/conans/{package_name}/{package_version}/{package_username}/{package_channel}/upload_urls"},
+		{Method: "Get", Path: fmt.Sprintf(conan, "v1") + "/packages/{conan_package_reference}/download_urls"},
+		{Method: "Get", Path: fmt.Sprintf(conan, "v2") + "/packages/{conan_package_reference}/download_urls"},
+		{Method: "Get", Path: fmt.Sprintf(conan, "v1") + "/revisions/{recipe_revision}/packages/{conan_package_reference}/revisions/{package_revision}/files"},
+		{Method: "Get", Path: fmt.Sprintf(conan, "v2") + "/revisions/{recipe_revision}/packages/{conan_package_reference}/revisions/{package_revision}/files"},
+	}
+
+	expected := map[string]string{
+		endpoints[0].Path: "get_pjs_pipeline_schedules_id",
+		endpoints[1].Path: "get_pjs_id_pipeline_schedules",
+		endpoints[2].Path: "delete_pjs_management_alerts_metric_images_id",
+		endpoints[3].Path: "post_pjs_alerts_metric_images_authorize",
+		endpoints[4].Path: "post_pjs_clusters_agents_tokens_revoke",
+		// The words are abbreviated at every occurrence.
+		endpoints[5].Path: "get_grps_id_descendant_grps",
+		endpoints[6].Path: "post_pjs_pkgs_conan_v1_conans_pkgs_upload_urls",
+		endpoints[7].Path: "post_pkgs_conan_v1_conans_upload_urls",
+		endpoints[8].Path: "get_pjs_conan_v1_conans_pkgs_download_urls",
+		endpoints[9].Path: "get_pjs_conan_v2_conans_pkgs_download_urls",
+		// The names collide without the words which differ between the paths.
+		endpoints[10].Path: "get_pjs_v1_revisions_pkgs_revisions_files",
+		endpoints[11].Path: "get_pjs_v2_revisions_pkgs_revisions_files",
+	}
+
+	// The names do not depend on the order of the endpoints.
+	reversed := slices.Clone(endpoints)
+	slices.Reverse(reversed)
+	for _, order := range [][]*endpoint{endpoints, reversed} {
+		if err := assignToolNames(order); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, e := range order {
+			if e.ToolName != expected[e.Path] {
+				t.Errorf("%s %s: got %s, want %s", e.Method, e.Path, e.ToolName, expected[e.Path])
+			}
+
+			e.ToolName = ""
+		}
+	}
+}
+
+func TestAssignToolNamesCollision(t *testing.T) {
+	endpoints := []*endpoint{
+		{Method: "Delete", Path: "/api/v4/projects/{id}/alert_management_alerts/{alert_iid}/metric_images/{metric_image_id}"},
+		{Method: "Delete", Path: "/api/v4/projects/{id}/alert_management_alerts/{alert_iid}/metric_images/{image_id}"},
+	}
+
+	if err := assignToolNames(endpoints); err == nil {
+		t.Errorf("got no error for the paths differing only in the parameter names")
+	}
+}
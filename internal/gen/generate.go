@@ -0,0 +1,465 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+var descriptionReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "'")
+
+// generatedHeader is the first line of the generated files.
+// The files having it in the output directory are removed before the generation.
+const generatedHeader = "// Code generated by internal/gen. DO NOT EDIT."
+
+var preceding = template.Must(template.New("preceding").Parse(generatedHeader + `
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+{{if .}}
+	client "github.com/9506hqwy/gitlab-client-go/pkg/gitlab"
+{{end}})
+`))
+
+var tool = template.Must(template.New("tool").Parse(`
+{{- if .Multipart}}
+type {{.Name}}FormBody struct {
+{{- range .FormFields}}
+	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}" jsonschema:"description={{.Description}}{{.Tag}}"` + "`" + `
+{{- end}}
+}
+{{end}}
+type {{.Name}}Request struct {
+{{- range .PathFields}}
+	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}" jsonschema:"description={{.Description}}{{.Tag}}"` + "`" + `
+{{- end}}
+	{{if .HasParams}}Params *client.{{.Method}}ApiV4{{.Api}}Params ` + "`" + `json:"params{{if not .ParamsRequired}},omitempty{{end}}"` + "`" + `{{end}}
+	{{if .Multipart}}Body {{.Name}}FormBody ` + "`" + `json:"body"` + "`" + `{{else if .HasBody}}Body client.{{.Method}}ApiV4{{.Api}}JSONRequestBody ` + "`" + `json:"body"` + "`" + `{{end}}
+}
+
+func register{{.Name}}(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&{{.Name}}Request{})
+{{- range .Constraints}}
+	constrain(schemaObj, "{{.Field}}", map[string]*jsonschema.Schema{
+{{- range .Properties}}
+		"{{.Name}}": {{.Literal}},
+{{- end}}
+	})
+{{- end}}
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("{{.ToolName}}",
+		mcp.WithDescription("{{.Description}}"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler({{.Handler}})))
+}
+
+func {{.Handler}}(ctx context.Context, request mcp.CallToolRequest, req {{.Name}}Request) (*mcp.CallToolResult, error) {
+	c, err := newClient(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+{{if .Multipart}}
+	contentType, body, err := multipartBody(ctx, req.Body)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return toResult(c.{{.Method}}ApiV4{{.Api}}WithBody(ctx{{.Args}}, contentType, body, authorizationHeader))
+{{- else}}
+	return toResult(c.{{.Method}}ApiV4{{.Api}}(ctx{{.Args}}, authorizationHeader))
+{{- end}}
+}
+`))
+
+var tools = template.Must(template.New("tools").Parse(generatedHeader + `
+
+package gitlab
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func RegisterTools(s *server.MCPServer, readonly bool) {
+{{- range .}}
+{{- if .Generated}}
+{{- if eq .Method "Get"}}
+	register{{.Name}}(s)
+{{- else}}
+	if !readonly {
+		register{{.Name}}(s)
+	}
+{{- end}}
+{{- else}}
+	// The {{.Unsupported}} request body is not supported.
+	// if !readonly { register{{.Name}}(s) }
+{{- end}}
+{{- end}}
+
+	registerExtraTools(s, readonly)
+}
+`))
+
+type field struct {
+	Name        string
+	Type        string
+	JSON        string
+	Description string
+	// Tag is the options of the jsonschema struct tag such as ',enum=opened,enum=closed'.
+	Tag string
+}
+
+type toolData struct {
+	Name        string
+	Method      string
+	Api         string
+	Handler     string
+	ToolName    string
+	Description string
+	Generated   bool
+	// Unsupported is the content types of the request body if the code is not generated.
+	Unsupported string
+	PathFields  []field
+	HasParams   bool
+	// ParamsRequired is true if a query parameter is required.
+	ParamsRequired bool
+	HasBody        bool
+	Multipart      bool
+	FormFields     []field
+	Constraints    []constraints
+	Args           string
+}
+
+// description normalizes white spaces and escapes the description for a string literal and a struct tag.
+func description(value *string) string {
+	if value == nil {
+		return "null"
+	}
+
+	return descriptionReplacer.Replace(strings.Join(strings.Fields(*value), " "))
+}
+
+func paramType(sc schema) string {
+	switch sc.Type {
+	case "integer":
+		if sc.Format == "int32" {
+			return "int32"
+		}
+
+		return "int"
+	case "boolean":
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// formType returns the Go type of the form field, or an empty string if the field can not be sent.
+func formType(sc schema) string {
+	switch {
+	case sc.Format == "binary":
+		return "FileContent"
+	case sc.Type == "integer":
+		return paramType(sc)
+	case sc.Type == "number":
+		return "float64"
+	case sc.Type == "boolean":
+		return "bool"
+	case sc.Type == "array" && sc.Items != nil && sc.Items.Type == "integer":
+		return "[]int"
+	case sc.Type == "array":
+		return "[]string"
+	case sc.Type == "object":
+		// A nested object can not be sent as a form field.
+		return ""
+	default:
+		return "string"
+	}
+}
+
+func (s *spec) formFields(op *operation) ([]field, error) {
+	sc, err := s.formSchema(op)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := sc.properties()
+	if err != nil {
+		return nil, err
+	}
+
+	required := map[string]bool{}
+	for _, name := range sc.Required {
+		required[name] = true
+	}
+
+	fields := []field{}
+	for _, p := range properties {
+		ty := formType(p.Schema)
+		if ty == "" {
+			continue
+		}
+
+		name := p.Name
+		if !required[p.Name] {
+			name += ",omitempty"
+		}
+
+		fields = append(fields, field{
+			Name:        varName(p.Name),
+			Type:        ty,
+			JSON:        name,
+			Description: description(p.Schema.Description),
+			Tag:         constraintTag(p.Schema),
+		})
+	}
+
+	return fields, nil
+}
+
+// toolData returns the template data of the endpoint.
+func (s *spec) toolData(e *endpoint) (*toolData, error) {
+	api := capitalize(toolName(e.Path))
+	data := &toolData{
+		Name:        e.Method + api,
+		Method:      e.Method,
+		Api:         api,
+		Handler:     strings.ToLower(e.Method[:1]) + e.Method[1:] + api + "Handler",
+		ToolName:    e.ToolName,
+		Description: description(e.Op.Description),
+		HasParams:   e.Op.queryParameters() != 0,
+		// The parameters are required to be specified if one of them is required.
+		ParamsRequired: e.Op.hasRequiredQuery(),
+	}
+
+	generated, err := s.isGenerated(e)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Generated = generated
+	if !generated {
+		data.Unsupported = strings.Join(slices.Sorted(maps.Keys(e.Op.RequestBody.Content)), ", ")
+		return data, nil
+	}
+
+	args := []string{}
+	for _, name := range pathParameterNames(e.Path) {
+		p := e.Op.pathParameter(name)
+		fieldName := varName(name)
+		data.PathFields = append(data.PathFields, field{
+			Name:        fieldName,
+			Type:        paramType(p.Schema),
+			JSON:        name,
+			Description: p.description(),
+			Tag:         constraintTag(p.Schema),
+		})
+		args = append(args, "req."+fieldName)
+	}
+
+	if data.HasParams {
+		args = append(args, "req.Params")
+	}
+
+	if e.hasBody() {
+		multipart, err := s.isMultipart(e.Op)
+		if err != nil {
+			return nil, err
+		}
+
+		data.Multipart = multipart
+		data.HasBody = !multipart
+		if multipart {
+			data.FormFields, err = s.formFields(e.Op)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			args = append(args, "req.Body")
+		}
+	}
+
+	for _, arg := range args {
+		data.Args += ", " + arg
+	}
+
+	data.Constraints, err = s.constraints(e, data.HasBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// hasBody reports whether the request body is sent. It is ignored for DELETE and GET.
+func (e *endpoint) hasBody() bool {
+	return (e.Method == "Post" || e.Method == "Put") && e.Op.hasBody()
+}
+
+func pathParameterNames(path string) []string {
+	names := []string{}
+	for segment := range strings.SplitSeq(path, "{") {
+		if name, _, ok := strings.Cut(segment, "}"); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// generate writes the Go files of the specification into the directory.
+// The tools are written into the file named by the API group, and all tools
+// are registered in tools.go. The files generated before are removed not to
+// leave the files of the groups removed from the specification.
+func generate(s *spec, dir string) error {
+	all := []*toolData{}
+	generated := []*endpoint{}
+	for _, e := range s.Endpoints {
+		ok, err := s.isGenerated(e)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", e.Method, e.Path, err)
+		}
+
+		if ok {
+			generated = append(generated, e)
+		}
+	}
+
+	if err := assignToolNames(generated); err != nil {
+		return err
+	}
+
+	files := map[string]*bytes.Buffer{}
+	groups := []string{}
+	names := map[string]*endpoint{}
+	for _, e := range s.Endpoints {
+		data, err := s.toolData(e)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", e.Method, e.Path, err)
+		}
+
+		if other, ok := names[data.Name]; ok {
+			return fmt.Errorf("%s %s: %s collides with %s %s", e.Method, e.Path, data.Name, other.Method, other.Path)
+		}
+
+		names[data.Name] = e
+
+		all = append(all, data)
+		if !data.Generated {
+			continue
+		}
+
+		group, _ := pathSegments(e.Path)
+		if files[group] == nil {
+			files[group] = &bytes.Buffer{}
+			groups = append(groups, group)
+		}
+
+		if err := tool.Execute(files[group], data); err != nil {
+			return err
+		}
+	}
+
+	sources := map[string][]byte{}
+	for _, group := range groups {
+		body := files[group].Bytes()
+
+		var content bytes.Buffer
+		if err := preceding.Execute(&content, bytes.Contains(body, []byte("client."))); err != nil {
+			return err
+		}
+
+		_, _ = content.Write(body)
+		if err := formatSource(sources, group+".go", content.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	var content bytes.Buffer
+	if err := tools.Execute(&content, all); err != nil {
+		return err
+	}
+
+	if err := formatSource(sources, "tools.go", content.Bytes()); err != nil {
+		return err
+	}
+
+	// The files are replaced after all files are generated successfully.
+	if err := removeGenerated(dir); err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sources)) {
+		if err := os.WriteFile(filepath.Join(dir, name), sources[name], 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeGenerated removes the Go files starting with generatedHeader in the directory.
+func removeGenerated(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" {
+			continue
+		}
+
+		name := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.HasPrefix(content, []byte(generatedHeader+"\n")) {
+			continue
+		}
+
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatSource formats the content and stores it into the sources by the file name.
+func formatSource(sources map[string][]byte, name string, content []byte) error {
+	source, err := format.Source(content)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	sources[name] = source
+	return nil
+}
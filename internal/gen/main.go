@@ -0,0 +1,26 @@
+// Gen generates the tools of the GitLab REST API from the OpenAPI specification.
+//
+//	go run ./internal/gen -spec .openapi/openapi.yml -out pkg/gitlab
+//
+// The specification is downloaded by scripts/fetch-openapi.sh.
+package main
+
+import (
+	"flag"
+	"log"
+)
+
+func main() {
+	specPath := flag.String("spec", ".openapi/openapi.yml", "OpenAPI specification file.")
+	outDir := flag.String("out", ".", "Output directory.")
+	flag.Parse()
+
+	s, err := loadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generate(s, *outDir); err != nil {
+		log.Fatal(err)
+	}
+}
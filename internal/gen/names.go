@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxToolNameLength is the maximum length of a tool name.
+// See https://github.com/microsoft/vscode/blob/1.101.2/src/vs/workbench/contrib/mcp/common/mcpTypes.ts#L710-L714
+const maxToolNameLength = 46
+
+var (
+	toolNameReplacer = strings.NewReplacer(".", "_", "-", "_", "/", "_", "$", "_", "{", "", "}", "", "(", "", ")", "")
+	varNameReplacer  = strings.NewReplacer("-", "_", ".", "", "[", "_", "]", "_")
+)
+
+// abbreviations are applied to every word of all tool names.
+// The words are kept as they are to keep the existing tool names.
+var abbreviations = map[string]string{
+	"projects":       "pjs",
+	"groups":         "grps",
+	"packages":       "pkgs",
+	"pipelines":      "pls",
+	"repository":     "repo",
+	"merge_requests": "mrs",
+}
+
+// capitalize converts a snake case name to a pascal case name.
+func capitalize(value string) string {
+	words := []string{}
+	for word := range strings.SplitSeq(value, "_") {
+		if word == "" {
+			continue
+		}
+
+		words = append(words, strings.ToUpper(word[:1])+word[1:])
+	}
+
+	return strings.Join(words, "")
+}
+
+// pathSegments returns the API group and the rest of the path such as '/api/v4/<group>/<api>'.
+func pathSegments(path string) (group string, api string) {
+	segments := strings.Split(path, "/")
+	if len(segments) > 3 {
+		group = segments[3]
+	}
+
+	if len(segments) > 4 {
+		api = strings.Join(segments[4:], "/")
+	}
+
+	return group, api
+}
+
+// toolName returns the snake case name of the path.
+func toolName(path string) string {
+	group, api := pathSegments(path)
+	return toolNameReplacer.Replace(strings.ToLower(group) + "_" + strings.ToLower(api))
+}
+
+// varName returns the Go field name of the parameter.
+func varName(name string) string {
+	return capitalize(varNameReplacer.Replace(name))
+}
+
+// nameWord is a word of a tool name.
+type nameWord struct {
+	Text string
+	// Param is true if the word is in a path parameter.
+	Param bool
+}
+
+// splitWords returns the abbreviated words of the snake case name.
+func splitWords(name string) []string {
+	words := []string{}
+	for word := range strings.SplitSeq(name, "_") {
+		if word == "" {
+			continue
+		}
+
+		if n := len(words); n > 0 && words[n-1]+"_"+word == "merge_requests" {
+			words[n-1] = abbreviations["merge_requests"]
+			continue
+		}
+
+		if abbr, ok := abbreviations[word]; ok {
+			word = abbr
+		}
+
+		words = append(words, word)
+	}
+
+	return words
+}
+
+// nameWords returns the words of the tool name of the endpoint and the number of the leading words
+// which are always kept: the method and the API group. If short is true, a path parameter is
+// represented by its last word such as 'iid' of '{merge_request_iid}'.
+func nameWords(method string, path string, short bool) (words []nameWord, fixed int) {
+	words = []nameWord{{Text: strings.ToLower(method)}}
+	group, api := pathSegments(path)
+	for _, word := range splitWords(toolName("/api/v4/" + group)) {
+		words = append(words, nameWord{Text: word})
+	}
+
+	fixed = len(words)
+	for segment := range strings.SplitSeq(api, "/") {
+		param := strings.HasPrefix(segment, "{")
+		segmentWords := splitWords(toolNameReplacer.Replace(strings.ToLower(segment)))
+		if param && short && len(segmentWords) > 0 {
+			segmentWords = segmentWords[len(segmentWords)-1:]
+		}
+
+		for _, word := range segmentWords {
+			words = append(words, nameWord{Text: word, Param: param})
+		}
+	}
+
+	return words, fixed
+}
+
+// joinWords returns the tool name of the selected words.
+func joinWords(words []nameWord, selected map[int]bool) string {
+	texts := []string{}
+	for i, word := range words {
+		if selected == nil || selected[i] {
+			texts = append(texts, word.Text)
+		}
+	}
+
+	return strings.Join(texts, "_")
+}
+
+// shorten returns the tool name of the words shortened to maxToolNameLength characters.
+// The path parameters before the resource words are dropped first, and the resource words
+// after the API group are dropped from the first next, so that the last resource words remain.
+// The leading fixed words, the last word and the words in keep are not dropped.
+func shorten(words []nameWord, fixed int, keep map[int]bool) (string, error) {
+	selected := map[int]bool{}
+	for i := range words {
+		selected[i] = true
+	}
+
+	droppable := func(i int) bool {
+		return fixed <= i && i < len(words)-1 && !keep[i]
+	}
+
+	for i, word := range words {
+		if word.Param && droppable(i) {
+			selected[i] = false
+		}
+	}
+
+	for i := range words {
+		if name := joinWords(words, selected); len(name) <= maxToolNameLength {
+			return name, nil
+		}
+
+		if droppable(i) {
+			selected[i] = false
+		}
+	}
+
+	return "", fmt.Errorf("%s: too long to shorten", joinWords(words, selected))
+}
+
+// firstDifference returns the index of the first word which differs between the words.
+func firstDifference(a []nameWord, b []nameWord) int {
+	for i := range a {
+		if len(b) <= i || a[i].Text != b[i].Text {
+			return i
+		}
+	}
+
+	return len(a) - 1
+}
+
+// assignToolNames assigns unique tool names to the endpoints.
+// The tool names which fit in maxToolNameLength are kept, and the others are shortened.
+// If shortened names collide, the first words which differ between their paths are kept
+// in the names. The names do not depend on the order of the endpoints.
+func assignToolNames(endpoints []*endpoint) error {
+	full := map[string][]*endpoint{}
+	for _, e := range endpoints {
+		words, _ := nameWords(e.Method, e.Path, false)
+		name := joinWords(words, nil)
+		full[name] = append(full[name], e)
+	}
+
+	type shortened struct {
+		words []nameWord
+		fixed int
+		keep  map[int]bool
+	}
+
+	used := map[string]*endpoint{}
+	long := map[*endpoint]*shortened{}
+	for name, group := range full {
+		if len(name) <= maxToolNameLength && len(group) == 1 {
+			group[0].ToolName = name
+			used[name] = group[0]
+			continue
+		}
+
+		for _, e := range group {
+			words, fixed := nameWords(e.Method, e.Path, true)
+			long[e] = &shortened{words: words, fixed: fixed, keep: map[int]bool{}}
+		}
+	}
+
+	for {
+		names := map[string][]*endpoint{}
+		for e, sh := range long {
+			name, err := shorten(sh.words, sh.fixed, sh.keep)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", e.Method, e.Path, err)
+			}
+
+			e.ToolName = name
+			names[name] = append(names[name], e)
+		}
+
+		changed := false
+		collision := ""
+		for name, group := range names {
+			if other, ok := used[name]; ok {
+				group = append(group, other)
+			}
+
+			if len(group) == 1 {
+				continue
+			}
+
+			collision = name
+			for _, e := range group {
+				sh, ok := long[e]
+				if !ok {
+					continue
+				}
+
+				for _, other := range group {
+					if other == e {
+						continue
+					}
+
+					words, _ := nameWords(other.Method, other.Path, true)
+					if i := firstDifference(sh.words, words); !sh.keep[i] {
+						sh.keep[i] = true
+						changed = true
+					}
+				}
+			}
+		}
+
+		if collision == "" {
+			return nil
+		}
+
+		if !changed {
+			return fmt.Errorf("%s: tool name collides", collision)
+		}
+	}
+}
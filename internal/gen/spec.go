@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// methods are the HTTP methods in the order of the generated code.
+var methods = []string{"Delete", "Post", "Put", "Get"}
+
+type parameter struct {
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description *string `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      schema  `yaml:"schema"`
+}
+
+type schema struct {
+	Ref         string    `yaml:"$ref"`
+	Type        string    `yaml:"type"`
+	Format      string    `yaml:"format"`
+	Description *string   `yaml:"description"`
+	Enum        []any     `yaml:"enum"`
+	Default     any       `yaml:"default"`
+	Minimum     *float64  `yaml:"minimum"`
+	Maximum     *float64  `yaml:"maximum"`
+	Items       *schema   `yaml:"items"`
+	Required    []string  `yaml:"required"`
+	Properties  yaml.Node `yaml:"properties"`
+}
+
+type mediaType struct {
+	Schema schema `yaml:"schema"`
+}
+
+type requestBody struct {
+	Content map[string]mediaType `yaml:"content"`
+}
+
+type operation struct {
+	Description *string      `yaml:"description"`
+	Parameters  []parameter  `yaml:"parameters"`
+	RequestBody *requestBody `yaml:"requestBody"`
+}
+
+// property is a property of an object schema in the order of the specification.
+type property struct {
+	Name   string
+	Schema schema
+}
+
+// endpoint is an operation of the specification.
+type endpoint struct {
+	Method string
+	Path   string
+	Op     *operation
+	// ToolName is assigned by assignToolNames.
+	ToolName string
+}
+
+type spec struct {
+	root      *yaml.Node
+	Endpoints []*endpoint
+}
+
+func loadSpec(name string) (*spec, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 {
+		return nil, fmt.Errorf("%s: empty document", name)
+	}
+
+	s := &spec{root: document.Content[0]}
+	paths := mappingValue(s.root, "paths")
+	if paths == nil {
+		return nil, fmt.Errorf("%s: paths not found", name)
+	}
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		path := paths.Content[i].Value
+		item := paths.Content[i+1]
+		for _, method := range methods {
+			node := mappingValue(item, strings.ToLower(method))
+			if node == nil {
+				continue
+			}
+
+			var op operation
+			if err := node.Decode(&op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+
+			s.Endpoints = append(s.Endpoints, &endpoint{Method: method, Path: path, Op: &op})
+		}
+	}
+
+	return s, nil
+}
+
+// mappingValue returns the value of the key in the mapping node.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// resolve returns the schema referenced by '$ref' such as '#/components/schemas/Name'.
+func (s *spec) resolve(sc schema) (schema, error) {
+	if sc.Ref == "" {
+		return sc, nil
+	}
+
+	node := s.root
+	for key := range strings.SplitSeq(strings.TrimPrefix(sc.Ref, "#/"), "/") {
+		node = mappingValue(node, key)
+		if node == nil {
+			return schema{}, fmt.Errorf("%s: not found", sc.Ref)
+		}
+	}
+
+	var resolved schema
+	if err := node.Decode(&resolved); err != nil {
+		return schema{}, fmt.Errorf("%s: %w", sc.Ref, err)
+	}
+
+	return resolved, nil
+}
+
+// properties returns the properties of the object schema.
+func (sc schema) properties() ([]property, error) {
+	properties := []property{}
+	for i := 0; i+1 < len(sc.Properties.Content); i += 2 {
+		var p schema
+		if err := sc.Properties.Content[i+1].Decode(&p); err != nil {
+			return nil, err
+		}
+
+		properties = append(properties, property{Name: sc.Properties.Content[i].Value, Schema: p})
+	}
+
+	return properties, nil
+}
+
+func (p parameter) description() string {
+	return description(p.Description)
+}
+
+// hasRequiredQuery reports whether the operation has a required query parameter.
+func (op *operation) hasRequiredQuery() bool {
+	for _, p := range op.Parameters {
+		if p.In == "query" && p.Required {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (op *operation) queryParameters() int {
+	n := 0
+	for _, p := range op.Parameters {
+		if p.In == "query" {
+			n++
+		}
+	}
+
+	return n
+}
+
+// pathParameter returns the parameter in the path.
+func (op *operation) pathParameter(name string) parameter {
+	for _, p := range op.Parameters {
+		if p.Name == name && p.In == "path" {
+			return p
+		}
+	}
+
+	for _, p := range op.Parameters {
+		if p.Name == name {
+			return p
+		}
+	}
+
+	return parameter{Name: name}
+}
+
+func (op *operation) hasBody() bool {
+	return op.RequestBody != nil
+}
+
+func (op *operation) isJSON() bool {
+	if op.RequestBody == nil {
+		return false
+	}
+
+	_, ok := op.RequestBody.Content["application/json"]
+	return ok
+}
+
+// formSchema returns the schema of the multipart/form-data or JSON request body.
+func (s *spec) formSchema(op *operation) (schema, error) {
+	if media, ok := op.RequestBody.Content["multipart/form-data"]; ok {
+		return s.resolve(media.Schema)
+	}
+
+	return s.resolve(op.RequestBody.Content["application/json"].Schema)
+}
+
+// isMultipart reports whether the request body is sent as multipart/form-data.
+// It is if the body is declared so or it has a binary property such as a file.
+func (s *spec) isMultipart(op *operation) (bool, error) {
+	if op.RequestBody == nil {
+		return false, nil
+	}
+
+	if _, ok := op.RequestBody.Content["multipart/form-data"]; ok {
+		return true, nil
+	}
+
+	if !op.isJSON() {
+		return false, nil
+	}
+
+	sc, err := s.formSchema(op)
+	if err != nil {
+		return false, err
+	}
+
+	properties, err := sc.properties()
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range properties {
+		if p.Schema.Format == "binary" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isGenerated reports whether the code of the endpoint is generated.
+// The request body must be empty, JSON or multipart/form-data.
+func (s *spec) isGenerated(e *endpoint) (bool, error) {
+	if !e.hasBody() || e.Op.isJSON() {
+		return true, nil
+	}
+
+	return s.isMultipart(e.Op)
+}
@@ -0,0 +1,250 @@
+package gitlab
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// auditKey is the context key of the audit log of the tool being called.
+type auditKey struct{}
+
+// AuditLog appends an entry in JSON Lines for each request to GitLab changing resources,
+// that is the request other than GET and HEAD. The file is rotated when it exceeds the
+// maximum size, and the rotated files such as 'audit-2006-01-02T15-04-05.000.jsonl' are
+// never removed.
+type AuditLog struct {
+	mu      sync.Mutex
+	name    string
+	maxSize int64
+	file    *os.File
+	size    int64
+	// users are the users resolved from the tokens by the hash of the URL and the token.
+	users map[string]*auditUser
+}
+
+type auditEntry struct {
+	Time        time.Time    `json:"time"`
+	Session     string       `json:"session,omitempty"`
+	Client      *auditClient `json:"client,omitempty"`
+	Instance    string       `json:"instance,omitempty"`
+	Url         string       `json:"url,omitempty"`
+	User        *auditUser   `json:"user,omitempty"`
+	Tool        string       `json:"tool,omitempty"`
+	Method      string       `json:"method"`
+	Path        string       `json:"path"`
+	QuerySha256 string       `json:"query_sha256,omitempty"`
+	BodySha256  string       `json:"body_sha256,omitempty"`
+	Status      int          `json:"status,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+type auditClient struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type auditUser struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// NewAuditLog opens the audit log file to append. The file is rotated when its size exceeds
+// maxSize bytes.
+func NewAuditLog(name string, maxSize int64) (*AuditLog, error) {
+	a := &AuditLog{name: name, maxSize: maxSize, users: map[string]*auditUser{}}
+	if err := a.open(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Close closes the audit log file.
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.file.Close()
+}
+
+// Middleware makes the requests to GitLab sent by the tool be audited.
+func (a *AuditLog) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = context.WithValue(ctx, toolNameKey{}, request.Params.Name)
+		ctx = context.WithValue(ctx, auditKey{}, a)
+		return next(ctx, request)
+	}
+}
+
+func (a *AuditLog) open() error {
+	file, err := os.OpenFile(a.name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	a.file = file
+	a.size = info.Size()
+	return nil
+}
+
+// rotate renames the audit log file with the current time, and opens the new one.
+func (a *AuditLog) rotate() error {
+	if err := a.file.Close(); err != nil {
+		return err
+	}
+
+	ext := filepath.Ext(a.name)
+	rotated := strings.TrimSuffix(a.name, ext) + "-" + time.Now().UTC().Format("2006-01-02T15-04-05.000") + ext
+	if err := os.Rename(a.name, rotated); err != nil {
+		return err
+	}
+
+	return a.open()
+}
+
+// write appends the entry and flushes it to the storage.
+func (a *AuditLog) write(entry *auditEntry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	content = append(content, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.size > 0 && a.size+int64(len(content)) > a.maxSize {
+		if err := a.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := a.file.Write(content)
+	a.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	return a.file.Sync()
+}
+
+// user returns the user authenticated by the token in the context. The user is resolved
+// once for each token, and nil is returned if it can not be resolved.
+func (a *AuditLog) user(ctx context.Context) *auditUser {
+	base, err := serverUrl(ctx)
+	if err != nil {
+		return nil
+	}
+
+	token, ok := ctx.Value(TokenKey{}).(string)
+	if !ok {
+		return nil
+	}
+
+	hash := sha256.Sum256([]byte(base + "\n" + token))
+	key := hex.EncodeToString(hash[:])
+
+	a.mu.Lock()
+	user, ok := a.users[key]
+	a.mu.Unlock()
+	if ok {
+		return user
+	}
+
+	user = &auditUser{}
+	if err := requestJSON(ctx, http.MethodGet, "/user", nil, nil, user); err != nil {
+		slog.WarnContext(ctx, "failed to resolve audited user", slog.String("error", err.Error()))
+		return nil
+	}
+
+	a.mu.Lock()
+	a.users[key] = user
+	a.mu.Unlock()
+	return user
+}
+
+// auditTransport writes the audit log entry of each request changing resources with the
+// user, the tool, the path, the hashes of the query and the request body, and the status code.
+// The query and the body are hashed because these may contain secrets.
+type auditTransport struct {
+	next http.RoundTripper
+	log  *AuditLog
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	entry := &auditEntry{
+		Time:   time.Now().UTC(),
+		Method: req.Method,
+		Path:   req.URL.EscapedPath(),
+	}
+
+	if req.URL.RawQuery != "" {
+		hash := sha256.Sum256([]byte(req.URL.RawQuery))
+		entry.QuerySha256 = hex.EncodeToString(hash[:])
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		hash := sha256.Sum256(body)
+		entry.BodySha256 = hex.EncodeToString(hash[:])
+	}
+
+	response, err := t.next.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = response.StatusCode
+	}
+
+	entry.Url, _ = serverUrl(ctx)
+	entry.User = t.log.user(ctx)
+	if name, ok := ctx.Value(instanceKey{}).(string); ok {
+		entry.Instance = name
+	}
+
+	if name, ok := ctx.Value(toolNameKey{}).(string); ok {
+		entry.Tool = name
+	}
+
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		entry.Session = session.SessionID()
+		if s, ok := session.(server.SessionWithClientInfo); ok {
+			info := s.GetClientInfo()
+			entry.Client = &auditClient{Name: info.Name, Version: info.Version}
+		}
+	}
+
+	if err := t.log.write(entry); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit log", slog.String("error", err.Error()))
+	}
+
+	return response, err
+}
@@ -0,0 +1,199 @@
+package gitlab_test
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+type auditUser struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type auditRecord struct {
+	Time        string    `json:"time"`
+	Instance    string    `json:"instance"`
+	Url         string    `json:"url"`
+	User        auditUser `json:"user"`
+	Tool        string    `json:"tool"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	QuerySha256 string    `json:"query_sha256"`
+	BodySha256  string    `json:"body_sha256"`
+	Status      int       `json:"status"`
+}
+
+// readAuditRecords reads the records from the audit log files in the directory in name order.
+func readAuditRecords(t *testing.T, dir string) ([]auditRecord, int) {
+	t.Helper()
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := []auditRecord{}
+	for _, name := range names {
+		file, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var record auditRecord
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+				t.Fatal(err)
+			}
+
+			records = append(records, record)
+		}
+
+		_ = file.Close()
+	}
+
+	return records, len(names)
+}
+
+func TestAuditLog(t *testing.T) {
+	dir := t.TempDir()
+	audit, err := gitlab.NewAuditLog(filepath.Join(dir, "audit.jsonl"), 1024*1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = audit.Close() })
+
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, false, server.WithToolHandlerMiddleware(audit.Middleware))
+
+	callTool(ctx, t, c, "get_pjs_id_issues", map[string]any{"id": "group/project"})
+	callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/project", "params": map[string]any{"title": "new issue"}})
+	callTool(ctx, t, c, "commit_changes", map[string]any{
+		"id":             "group/project",
+		"branch":         "main",
+		"commit_message": "update",
+		"actions":        []map[string]any{{"action": "create", "file_path": "a.txt", "content": "a"}},
+	})
+
+	expected := []auditRecord{}
+	for _, r := range fake.Requests() {
+		if r.Method != "POST" {
+			continue
+		}
+
+		record := auditRecord{Url: fake.URL, Method: r.Method, Path: r.Path}
+		if len(r.Query) != 0 {
+			hash := sha256.Sum256([]byte(r.Query.Encode()))
+			record.QuerySha256 = hex.EncodeToString(hash[:])
+		}
+
+		if len(r.Body) != 0 {
+			hash := sha256.Sum256(r.Body)
+			record.BodySha256 = hex.EncodeToString(hash[:])
+		}
+
+		expected = append(expected, record)
+	}
+
+	expected[0].Tool, expected[0].Status = "post_pjs_id_issues", 201
+	expected[1].Tool, expected[1].Status = "commit_changes", 400
+
+	records, files := readAuditRecords(t, dir)
+	if len(records) != len(expected) || len(expected) != 2 || files != 1 {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+
+	for i, record := range records {
+		if record.Time == "" || record.User != (auditUser{Id: 1, Username: gitlabtest.Username}) {
+			t.Errorf("unexpected record: %+v", record)
+		}
+
+		record.Time = ""
+		record.User = auditUser{}
+		if record != expected[i] {
+			t.Errorf("got %+v, want %+v", record, expected[i])
+		}
+	}
+}
+
+func TestAuditLogRotation(t *testing.T) {
+	dir := t.TempDir()
+	audit, err := gitlab.NewAuditLog(filepath.Join(dir, "audit.jsonl"), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = audit.Close() })
+
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, false, server.WithToolHandlerMiddleware(audit.Middleware))
+
+	callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/project", "params": map[string]any{"title": "first"}})
+	callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/project", "params": map[string]any{"title": "second"}})
+
+	records, files := readAuditRecords(t, dir)
+	if len(records) != 2 || files != 2 {
+		t.Errorf("unexpected records: %d in %d files", len(records), files)
+	}
+}
+
+func TestAuditLogInstance(t *testing.T) {
+	dir := t.TempDir()
+	audit, err := gitlab.NewAuditLog(filepath.Join(dir, "audit.jsonl"), 1024*1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = audit.Close() })
+
+	fake, _ := newFakeProject(t)
+	other := gitlabtest.NewServer(t)
+	other.AddProject(gitlabtest.Project{Name: "mirror", PathWithNamespace: "group/mirror"})
+	other.SetUser(2, "other-user")
+
+	s := server.NewMCPServer("GitLab MCP Server", "0.1.0", server.WithToolHandlerMiddleware(audit.Middleware))
+	gitlab.RegisterTools(s, false)
+	err = gitlab.RegisterInstances(s, map[string]gitlab.Instance{
+		"other": {Url: other.URL, Token: gitlabtest.Token, Readonly: false},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, ctx := connectTestClient(t, s, fake)
+
+	callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/mirror", "instance": "other", "params": map[string]any{"title": "new issue"}})
+	callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/project", "params": map[string]any{"title": "new issue"}})
+
+	records, _ := readAuditRecords(t, dir)
+	if len(records) != 2 {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+
+	expected := []auditRecord{
+		{Instance: "other", Url: other.URL, User: auditUser{Id: 2, Username: "other-user"}, Status: 201},
+		{Url: fake.URL, User: auditUser{Id: 1, Username: gitlabtest.Username}, Status: 201},
+	}
+
+	for i, record := range records {
+		actual := auditRecord{Instance: record.Instance, Url: record.Url, User: record.User, Status: record.Status}
+		if actual != expected[i] {
+			t.Errorf("got %+v, want %+v", actual, expected[i])
+		}
+	}
+
+	if len(other.Requests()) != 2 || other.Requests()[0].Method != "POST" {
+		t.Errorf("unexpected requests to the other instance: %+v", other.Requests())
+	}
+}
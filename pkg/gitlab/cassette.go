@@ -0,0 +1,422 @@
+package gitlab
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// redacted replaces the secrets in the cassette files.
+const redacted = "[REDACTED]"
+
+// secretHeaders are the headers scrubbed in the cassette files.
+var secretHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Job-Token",
+	"Private-Token",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// secretParameters are the query parameters scrubbed in the cassette files.
+var secretParameters = []string{
+	"access_token",
+	"job_token",
+	"private_token",
+}
+
+// secretFields are the JSON fields scrubbed in the request and response bodies of the cassette files.
+// The fields ending with secretFieldSuffixes such as 'runners_token' are also scrubbed.
+var secretFields = []string{
+	"client_secret",
+	"password",
+	"private_key",
+	"secret",
+	"token",
+}
+
+var secretFieldSuffixes = []string{
+	"_password",
+	"_secret",
+	"_token",
+}
+
+// cassetteBody is a request or response body. It is saved as base64 if it is not UTF-8 text.
+type cassetteBody struct {
+	Text   string `json:"text,omitempty"`
+	Base64 string `json:"base64,omitempty"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	// Url is the path and the query of the request without the server URL.
+	Url    string       `json:"url"`
+	Header http.Header  `json:"header"`
+	Body   cassetteBody `json:"body"`
+}
+
+type cassetteResponse struct {
+	StatusCode int          `json:"status_code"`
+	Status     string       `json:"status"`
+	Header     http.Header  `json:"header"`
+	Body       cassetteBody `json:"body"`
+}
+
+// cassette is a pair of a request and a response saved in a cassette file.
+type cassette struct {
+	// Key identifies the request to be replayed. See cassetteKey.
+	Key      string           `json:"key"`
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+// recordTransport sends the requests by the base transport and saves
+// the requests and the responses as cassette files in the directory.
+type recordTransport struct {
+	mu   sync.Mutex
+	dir  string
+	next int
+	base http.RoundTripper
+}
+
+// replayTransport serves the responses saved in the cassette files without sending the requests.
+// The responses of the same request are served in the recorded order, and the last response is
+// served repeatedly after all of them are served.
+type replayTransport struct {
+	mu        sync.Mutex
+	cassettes map[string][]*cassette
+	served    map[string]int
+}
+
+// NewRecordTransport returns the transport to record the traffic sent by base into the directory.
+// The default transport is used if base is nil. The cassette files are appended after the files
+// already in the directory.
+func NewRecordTransport(dir string, base http.RoundTripper) (http.RoundTripper, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	names, err := cassetteFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The files are numbered after the largest number not to overwrite the files
+	// even if some of them are removed.
+	next := 1
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(filepath.Base(name), "-")
+		if number, err := strconv.Atoi(prefix); err == nil {
+			next = max(next, number+1)
+		}
+	}
+
+	return &recordTransport{dir: dir, next: next, base: base}, nil
+}
+
+// NewReplayTransport returns the transport to replay the traffic recorded in the directory.
+func NewReplayTransport(dir string) (http.RoundTripper, error) {
+	names, err := cassetteFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &replayTransport{cassettes: map[string][]*cassette{}, served: map[string]int{}}
+	for _, name := range names {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var c cassette
+		if err := json.Unmarshal(content, &c); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		t.cassettes[c.Key] = append(t.cassettes[c.Key], &c)
+	}
+
+	return t, nil
+}
+
+// cassetteFiles returns the cassette files in the directory in the recorded order.
+func cassetteFiles(dir string) ([]string, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(content))
+
+	scrubbed := scrubBody(content)
+	header := scrubHeader(response.Header)
+	if header.Get("Content-Length") != "" {
+		header.Set("Content-Length", strconv.Itoa(len(scrubbed)))
+	}
+
+	c := cassette{
+		Key: cassetteKey(req, body),
+		Request: cassetteRequest{
+			Method: req.Method,
+			Url:    scrubUrl(req.URL).RequestURI(),
+			Header: scrubHeader(req.Header),
+			Body:   newCassetteBody(scrubBody(body)),
+		},
+		Response: cassetteResponse{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Header:     header,
+			Body:       newCassetteBody(scrubbed),
+		},
+	}
+
+	if err := t.save(&c); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// save writes the cassette to the next file such as '00001-get-0123456789ab.json'.
+func (t *recordTransport) save(c *cassette) error {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	name := fmt.Sprintf("%05d-%s-%s.json", t.next, strings.ToLower(c.Request.Method), c.Key[:12])
+	if err := os.WriteFile(filepath.Join(t.dir, name), content, 0o600); err != nil {
+		return err
+	}
+
+	t.next++
+	return nil
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := cassetteKey(req, body)
+
+	t.mu.Lock()
+	cassettes := t.cassettes[key]
+	index := min(t.served[key], len(cassettes)-1)
+	t.served[key]++
+	t.mu.Unlock()
+
+	if len(cassettes) == 0 {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, scrubUrl(req.URL).RequestURI())
+	}
+
+	recorded := cassettes[index].Response
+	content, err := recorded.Body.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        recorded.Status,
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody reads the request body and restores it to be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// cassetteKey returns the hash of the method, the path, the query without secrets,
+// the range and the body of the request. The body of multipart/form-data is not
+// hashed because the boundary is random.
+func cassetteKey(req *http.Request, body []byte) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s\n%s\n", req.Method, scrubUrl(req.URL).RequestURI(), req.Header.Get("Range"))
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		_, _ = h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// scrubUrl returns the copy of the URL whose secret query parameters are redacted.
+func scrubUrl(u *url.URL) *url.URL {
+	scrubbed := *u
+	query := scrubbed.Query()
+	for _, name := range secretParameters {
+		if query.Has(name) {
+			query.Set(name, redacted)
+		}
+	}
+
+	scrubbed.RawQuery = query.Encode()
+	return &scrubbed
+}
+
+// scrubHeader returns the copy of the header whose secret values are redacted.
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	if scrubbed == nil {
+		scrubbed = http.Header{}
+	}
+
+	for _, name := range secretHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, redacted)
+		}
+	}
+
+	return scrubbed
+}
+
+// scrubBody returns the JSON body whose secret fields are redacted.
+// The body is returned as is if it is not JSON or it has no secret fields.
+func scrubBody(content []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return content
+	}
+
+	if !scrubValue(value) {
+		return content
+	}
+
+	var scrubbed bytes.Buffer
+	encoder := json.NewEncoder(&scrubbed)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return content
+	}
+
+	return bytes.TrimSuffix(scrubbed.Bytes(), []byte("\n"))
+}
+
+// scrubValue redacts the secret fields in the decoded JSON value, and reports whether it is changed.
+func scrubValue(value any) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSecretField(key) {
+				if field != nil && field != "" {
+					v[key] = redacted
+					changed = true
+				}
+
+				continue
+			}
+
+			changed = scrubValue(field) || changed
+		}
+	case []any:
+		for _, item := range v {
+			changed = scrubValue(item) || changed
+		}
+	default:
+	}
+
+	return changed
+}
+
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if slices.Contains(secretFields, name) {
+		return true
+	}
+
+	for _, suffix := range secretFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newCassetteBody(content []byte) cassetteBody {
+	if utf8.Valid(content) {
+		return cassetteBody{Text: string(content)}
+	}
+
+	return cassetteBody{Base64: base64.StdEncoding.EncodeToString(content)}
+}
+
+func (b cassetteBody) bytes() ([]byte, error) {
+	if b.Base64 != "" {
+		return base64.StdEncoding.DecodeString(b.Base64)
+	}
+
+	return []byte(b.Text), nil
+}
@@ -0,0 +1,188 @@
+package gitlab_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "first"})
+	fake.AddFile(project.Id, "main", "go.mod", "module example\n")
+	c, ctx := newTestClient(t, fake, true)
+
+	dir := t.TempDir()
+	recorder, err := gitlab.NewRecordTransport(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recordCtx := context.WithValue(ctx, gitlab.TransportKey{}, recorder)
+	listIssues := map[string]any{"id": "group/project"}
+	readFiles := map[string]any{"id": "group/project", "files": []map[string]any{{"path": "go.mod"}}}
+
+	first, _ := callTool(recordCtx, t, c, "get_pjs_id_issues", listIssues)
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "second"})
+	second, _ := callTool(recordCtx, t, c, "get_pjs_id_issues", listIssues)
+	file, _ := callTool(recordCtx, t, c, "read_repository_files", readFiles)
+	missing, _ := callTool(recordCtx, t, c, "get_pjs_id", map[string]any{"id": "group/missing"})
+
+	if first == second {
+		t.Fatalf("issues are not changed: %s", second)
+	}
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(names) != len(fake.Requests()) {
+		t.Errorf("%d cassettes for %d requests", len(names), len(fake.Requests()))
+	}
+
+	for _, name := range names {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Contains(string(content), gitlabtest.Token) {
+			t.Errorf("%s contains the token", name)
+		}
+	}
+
+	fake.Close()
+
+	replayer, err := gitlab.NewReplayTransport(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayCtx := context.WithValue(ctx, gitlab.TransportKey{}, replayer)
+	tests := []struct {
+		name      string
+		tool      string
+		arguments map[string]any
+		expected  string
+	}{
+		{name: "first response", tool: "get_pjs_id_issues", arguments: listIssues, expected: first},
+		{name: "second response", tool: "get_pjs_id_issues", arguments: listIssues, expected: second},
+		{name: "last response repeated", tool: "get_pjs_id_issues", arguments: listIssues, expected: second},
+		{name: "extra tool", tool: "read_repository_files", arguments: readFiles, expected: file},
+		{name: "error response", tool: "get_pjs_id", arguments: map[string]any{"id": "group/missing"}, expected: missing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if text, _ := callTool(replayCtx, t, c, tt.tool, tt.arguments); text != tt.expected {
+				t.Errorf("got %s, want %s", text, tt.expected)
+			}
+		})
+	}
+
+	text, isError := callTool(replayCtx, t, c, "get_pjs_id", map[string]any{"id": "group/other"})
+	if !isError || !strings.Contains(text, "no recorded response for GET /api/v4/projects/group%2Fother") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+}
+
+func TestRecordScrubBody(t *testing.T) {
+	response := `{"id":1,"name":"bot","token":"glpat-response","runners_token":"runner-secret","hooks":[{"url":"https://example.com/?a=1&b=2","password":"hook-secret"}],"expires_at":null}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	recorder, err := gitlab.NewRecordTransport(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"title":"new token","password":"hunter2","scopes":["api"]}`
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v4/user/personal_access_tokens", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer res.Body.Close()
+
+	// The response is returned without scrubbing.
+	content, err := io.ReadAll(res.Body)
+	if err != nil || string(content) != response {
+		t.Errorf("response = %s, %v", content, err)
+	}
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil || len(names) != 1 {
+		t.Fatalf("cassettes = %v, %v", names, err)
+	}
+
+	cassette, err := os.ReadFile(names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"glpat-response", "runner-secret", "hook-secret", "hunter2"} {
+		if strings.Contains(string(cassette), secret) {
+			t.Errorf("%s is recorded: %s", secret, cassette)
+		}
+	}
+
+	for _, value := range []string{"new token", "https://example.com/", `\"expires_at\":null`} {
+		if !strings.Contains(string(cassette), value) {
+			t.Errorf("%s is not recorded: %s", value, cassette)
+		}
+	}
+}
+
+func TestRecordNextNumber(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	// The second file is removed after recording.
+	dir := t.TempDir()
+	existing := map[string]string{
+		"00001-get-000000000001.json": `{"key":"1"}`,
+		"00003-get-000000000003.json": `{"key":"3"}`,
+	}
+
+	for name, content := range existing {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recorder, err := gitlab.NewRecordTransport(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	callTool(context.WithValue(ctx, gitlab.TransportKey{}, recorder), t, c, "get_pjs_id", map[string]any{"id": "group/project"})
+
+	for name, content := range existing {
+		if actual, err := os.ReadFile(filepath.Join(dir, name)); err != nil || string(actual) != content {
+			t.Errorf("%s is overwritten: %s, %v", name, actual, err)
+		}
+	}
+
+	names, err := filepath.Glob(filepath.Join(dir, "00004-get-*.json"))
+	if err != nil || len(names) != 1 {
+		t.Errorf("recorded files = %v, %v", names, err)
+	}
+}
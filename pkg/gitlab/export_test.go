@@ -0,0 +1,16 @@
+package gitlab
+
+// These export the unexported functions to the tests in package gitlab_test.
+var (
+	MatchGlob       = matchGlob
+	IsVendored      = isVendored
+	LintMessages    = lintMessages
+	UploadFile      = uploadFile
+	ReadFileContent = FileContent.read
+)
+
+// These are changed by the tests to exceed the limits with small files.
+var (
+	MaxArtifactsBufferBytes = &maxArtifactsBufferBytes
+	MaxUploadBytes          = &maxUploadBytes
+)
@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxConcurrentRequests is the maximum number of requests sent concurrently by one tool call.
+const maxConcurrentRequests = 8
+
+// notifyProgress sends a progress notification to the client if the client requested it.
+func notifyProgress(ctx context.Context, request mcp.CallToolRequest, progress float64, total float64, message string) {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return
+	}
+
+	s := server.ServerFromContext(ctx)
+	if s == nil {
+		return
+	}
+
+	params := map[string]any{
+		"progressToken": request.Params.Meta.ProgressToken,
+		"progress":      progress,
+		"message":       message,
+	}
+
+	if total > 0 {
+		params["total"] = total
+	}
+
+	// The notification is best effort, and it must not fail the tool call.
+	_ = s.SendNotificationToClient(ctx, string(mcp.MethodNotificationProgress), params)
+}
+
+// forEachConcurrently calls fn for each index in [0, n) with at most
+// maxConcurrentRequests goroutines, and waits for all of them.
+func forEachConcurrently(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentRequests)
+	for i := range n {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			fn(i)
+		})
+	}
+
+	wg.Wait()
+}
+
+// registerExtraTools registers the hand-written tools.
+// These are not generated by internal/gen because the operations are missing
+// in the OpenAPI specification or because the tools combine several operations.
+func registerExtraTools(s *server.MCPServer, readonly bool) {
+	registerGetTodos(s)
+	if !readonly {
+		registerPostTodosIdMarkAsDone(s)
+	}
+	if !readonly {
+		registerPostTodosMarkAsDone(s)
+	}
+	if !readonly {
+		registerPostProjectsIdMergeRequestsMergeRequestIidTodo(s)
+	}
+	registerGetProjectsIdBoards(s)
+	registerGetProjectsIdBoardsBoardIdLists(s)
+	if !readonly {
+		registerPostProjectsIdBoardsBoardIdLists(s)
+	}
+	if !readonly {
+		registerPutProjectsIdBoardsBoardIdListsListId(s)
+	}
+	registerGetGroupsIdBoards(s)
+	registerGetGroupsIdBoardsBoardIdLists(s)
+	if !readonly {
+		registerPostGroupsIdBoardsBoardIdLists(s)
+	}
+	if !readonly {
+		registerPutGroupsIdBoardsBoardIdListsListId(s)
+	}
+	registerGetBoardIssues(s)
+	if !readonly {
+		registerMoveBoardIssue(s)
+	}
+	registerGetCurrentUser(s)
+	registerGetUsers(s)
+	registerGetUsersId(s)
+	registerGetUsersIdStatus(s)
+	registerGetUsersIdKeys(s)
+	registerGetUsersIdGpgKeys(s)
+	registerGetUsersIdMemberships(s)
+	registerListRepositoryFiles(s)
+	registerReadRepositoryFiles(s)
+	if !readonly {
+		registerCommitChanges(s)
+	}
+	if !readonly {
+		registerProposeChange(s)
+	}
+	registerGetMrReviewContext(s)
+	registerDiagnosePipeline(s)
+	registerGetJobLog(s)
+	registerWaitForPipeline(s)
+	registerWaitForJob(s)
+	registerListJobArtifacts(s)
+	registerReadJobArtifact(s)
+	registerLintCiConfig(s)
+}
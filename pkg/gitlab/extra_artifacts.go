@@ -0,0 +1,342 @@
+package gitlab
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// artifactsBlockSize is the minimum size of a range request.
+const artifactsBlockSize = 64 * 1024
+
+// maxArtifactsBufferBytes is the maximum size of an archive buffered into a temporary file
+// when the server does not support range requests.
+var maxArtifactsBufferBytes int64 = 100 * 1024 * 1024
+
+// rangeReader reads a remote file by HTTP range requests.
+// The last block is cached because the zip reader reads the central directory sequentially.
+type rangeReader struct {
+	ctx    context.Context
+	path   string
+	size   int64
+	offset int64
+	block  []byte
+}
+
+func (r *rangeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	hit := r.offset <= off && off+int64(len(p)) <= r.offset+int64(len(r.block))
+	countCache("artifacts_block", hit)
+	if !hit {
+		end := min(r.size, off+int64(max(len(p), artifactsBlockSize)))
+		block, err := r.fetch(off, end)
+		if err != nil {
+			return 0, err
+		}
+
+		r.offset = off
+		r.block = block
+	}
+
+	n := copy(p, r.block[off-r.offset:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// fetch gets the bytes from start to end (exclusive).
+func (r *rangeReader) fetch(start int64, end int64) ([]byte, error) {
+	response, err := rangeRequest(r.ctx, r.path, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusOK {
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("the server does not support range requests")
+	}
+
+	content, err := readResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(content)) != end-start {
+		return nil, fmt.Errorf("unexpected range response: %d bytes", len(content))
+	}
+
+	return content, nil
+}
+
+func rangeRequest(ctx context.Context, path string, start int64, end int64) (*http.Response, error) {
+	req, err := newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end-1))
+	return newHTTPClient(ctx).Do(req)
+}
+
+// artifactsArchive is the artifacts archive of a job.
+type artifactsArchive struct {
+	*zip.Reader
+	Size int64
+	// file is the temporary file buffering the archive.
+	file *os.File
+}
+
+// Close removes the temporary file if the archive is buffered.
+func (a *artifactsArchive) Close() error {
+	if a.file == nil {
+		return nil
+	}
+
+	_ = a.file.Close()
+	return os.Remove(a.file.Name())
+}
+
+// openArtifacts opens the artifacts archive of the job.
+// The archive is read by range requests, or it is buffered into a temporary file
+// when the server does not support range requests.
+func openArtifacts(ctx context.Context, id string, jobId int) (*artifactsArchive, error) {
+	path := fmt.Sprintf("/projects/%s/jobs/%s/artifacts", pathEscape(id), pathEscape(jobId))
+
+	response, err := rangeRequest(ctx, path, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusPartialContent {
+		// Content-Range: bytes 0-0/<size>
+		contentRange := response.Header.Get("Content-Range")
+		if _, err := readResponse(response); err != nil {
+			return nil, err
+		}
+
+		i := strings.LastIndex(contentRange, "/")
+		size, err := strconv.ParseInt(contentRange[i+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Content-Range %q", contentRange)
+		}
+
+		reader, err := zip.NewReader(&rangeReader{ctx: ctx, path: path, size: size}, size)
+		if err != nil {
+			return nil, err
+		}
+
+		return &artifactsArchive{Reader: reader, Size: size}, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		_, err := readResponse(response)
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	file, err := os.CreateTemp("", "gitlab-artifacts-*.zip")
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &artifactsArchive{file: file}
+	archive.Size, err = io.Copy(file, io.LimitReader(response.Body, maxArtifactsBufferBytes+1))
+	if err == nil && archive.Size > maxArtifactsBufferBytes {
+		err = fmt.Errorf("the artifacts archive exceeds %d bytes", maxArtifactsBufferBytes)
+	}
+
+	if err == nil {
+		archive.Reader, err = zip.NewReader(file, archive.Size)
+	}
+
+	if err != nil {
+		_ = archive.Close()
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+type artifactEntry struct {
+	Name           string    `json:"name"`
+	Size           uint64    `json:"size"`
+	CompressedSize uint64    `json:"compressed_size"`
+	Modified       time.Time `json:"modified"`
+}
+
+type ListJobArtifactsRequest struct {
+	Id      string   `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	JobId   int      `json:"job_id" jsonschema:"description=The ID of a job."`
+	Include []string `json:"include,omitempty" jsonschema:"description=Glob patterns of the entries to list (e.g. '**/*.xml'). '**' matches any number of directories and a pattern without '/' matches the file name at any depth."`
+	Limit   int      `json:"limit,omitempty" jsonschema:"description=Maximum number of entries to list. Default is 1000.,minimum=1,maximum=10000"`
+}
+
+func registerListJobArtifacts(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&ListJobArtifactsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("list_job_artifacts",
+		mcp.WithDescription("List the files in the artifacts archive of a job with their sizes. Only the central directory of the archive is read."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(listJobArtifactsHandler)))
+}
+
+func listJobArtifactsHandler(ctx context.Context, request mcp.CallToolRequest, req ListJobArtifactsRequest) (*mcp.CallToolResult, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	archive, err := openArtifacts(ctx, req.Id, req.JobId)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	defer archive.Close()
+
+	entries := []artifactEntry{}
+	truncated := false
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		if len(req.Include) > 0 && !matchAnyGlob(req.Include, file.Name) {
+			continue
+		}
+
+		if len(entries) == limit {
+			truncated = true
+			break
+		}
+
+		entries = append(entries, artifactEntry{
+			Name:           file.Name,
+			Size:           file.UncompressedSize64,
+			CompressedSize: file.CompressedSize64,
+			Modified:       file.Modified,
+		})
+	}
+
+	return toJSONResult(map[string]any{
+		"archive_size": archive.Size,
+		"entries":      entries,
+		"truncated":    truncated,
+	})
+}
+
+type ReadJobArtifactRequest struct {
+	Id       string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	JobId    int    `json:"job_id" jsonschema:"description=The ID of a job."`
+	Path     string `json:"path" jsonschema:"description=Path of the file inside the artifacts archive."`
+	MaxBytes int    `json:"max_bytes,omitempty" jsonschema:"description=Maximum number of bytes to return. Default is 100000.,minimum=1,maximum=1000000"`
+}
+
+func registerReadJobArtifact(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&ReadJobArtifactRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("read_job_artifact",
+		mcp.WithDescription("Read a text file such as a JUnit report or a coverage report from the artifacts archive of a job without downloading the whole archive. The content is truncated to max_bytes and binary files are not returned."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(readJobArtifactHandler)))
+}
+
+func readJobArtifactHandler(ctx context.Context, request mcp.CallToolRequest, req ReadJobArtifactRequest) (*mcp.CallToolResult, error) {
+	maxBytes := req.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = 100000
+	}
+
+	archive, err := openArtifacts(ctx, req.Id, req.JobId)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	defer archive.Close()
+
+	name := strings.TrimPrefix(req.Path, "/")
+	var entry *zip.File
+	for _, file := range archive.File {
+		if file.Name == name {
+			entry = file
+			break
+		}
+	}
+
+	if entry == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("%s: not found in the artifacts archive", req.Path)), nil
+	}
+
+	reader, err := entry.Open()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, int64(maxBytes)))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	truncated := uint64(len(content)) < entry.UncompressedSize64
+	result := map[string]any{
+		"path":      entry.Name,
+		"size":      entry.UncompressedSize64,
+		"truncated": truncated,
+	}
+
+	if truncated {
+		content = trimIncompleteRune(content)
+	}
+
+	if isBinary(content) {
+		result["binary"] = true
+		return toJSONResult(result)
+	}
+
+	result["content"] = string(content)
+	return toJSONResult(result)
+}
@@ -0,0 +1,170 @@
+package gitlab_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+const testReport = `<testsuite name="pkg" tests="1"><testcase name="TestX"/></testsuite>`
+
+type artifactEntry struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+type artifactList struct {
+	ArchiveSize int64           `json:"archive_size"`
+	Entries     []artifactEntry `json:"entries"`
+	Truncated   bool            `json:"truncated"`
+}
+
+type artifactFile struct {
+	Path      string `json:"path"`
+	Size      int    `json:"size"`
+	Truncated bool   `json:"truncated"`
+	Binary    bool   `json:"binary"`
+	Content   string `json:"content"`
+}
+
+// newTestArtifacts adds a job with the artifacts archive which is larger than a block of the range requests.
+func newTestArtifacts(t *testing.T) (*gitlabtest.Server, *gitlabtest.Job) {
+	t.Helper()
+
+	// The random bytes are not compressed.
+	random := make([]byte, 200*1024)
+	for i := range random {
+		random[i] = byte(rand.IntN(256))
+	}
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{name: "coverage.bin", content: random},
+		{name: "report/junit.xml", content: []byte(testReport)},
+		{name: "report/multibyte.txt", content: []byte(strings.Repeat("漢字", 100))},
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, f := range files {
+		w, err := writer.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := w.Write(f.content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fake, project := newFakeProject(t)
+	pipeline := fake.AddPipeline(project.Id, gitlabtest.Pipeline{Ref: "main", Sha: "abc"})
+	job := fake.AddJob(pipeline.Id, gitlabtest.Job{Name: "test", Stage: "test", Artifacts: buffer.Bytes()})
+	return fake, job
+}
+
+func TestJobArtifactsRange(t *testing.T) {
+	fake, job := newTestArtifacts(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	var list artifactList
+	callToolJSON(ctx, t, c, "list_job_artifacts", map[string]any{
+		"id":      "group/project",
+		"job_id":  job.Id,
+		"include": []string{"report/*.xml"},
+	}, &list)
+
+	if list.ArchiveSize != int64(len(job.Artifacts)) || len(list.Entries) != 1 || list.Entries[0].Name != "report/junit.xml" {
+		t.Errorf("unexpected list: %+v", list)
+	}
+
+	var file artifactFile
+	callToolJSON(ctx, t, c, "read_job_artifact", map[string]any{
+		"id":     "group/project",
+		"job_id": job.Id,
+		"path":   "report/junit.xml",
+	}, &file)
+
+	if file.Content != testReport || file.Truncated || file.Size != len(testReport) {
+		t.Errorf("unexpected file: %+v", file)
+	}
+
+	// The archive is read by range requests without downloading the whole archive.
+	for _, r := range fake.Requests() {
+		if strings.HasSuffix(r.Path, "/artifacts") && r.Header.Get("Range") == "" {
+			t.Errorf("request without Range: %s %s", r.Method, r.Path)
+		}
+	}
+}
+
+func TestReadJobArtifactTruncated(t *testing.T) {
+	fake, job := newTestArtifacts(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	// The 10th byte is in the middle of a multibyte character.
+	var file artifactFile
+	callToolJSON(ctx, t, c, "read_job_artifact", map[string]any{
+		"id":        "group/project",
+		"job_id":    job.Id,
+		"path":      "report/multibyte.txt",
+		"max_bytes": 10,
+	}, &file)
+
+	if !file.Truncated || file.Binary || file.Content != "漢字漢" || !utf8.ValidString(file.Content) {
+		t.Errorf("unexpected file: %+v", file)
+	}
+}
+
+func TestJobArtifactsBuffered(t *testing.T) {
+	fake, job := newTestArtifacts(t)
+	fake.SetRangeRequests(false)
+	c, ctx := newTestClient(t, fake, true)
+
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+
+	arguments := map[string]any{
+		"id":     "group/project",
+		"job_id": job.Id,
+		"path":   "report/junit.xml",
+	}
+
+	var file artifactFile
+	callToolJSON(ctx, t, c, "read_job_artifact", arguments, &file)
+
+	if file.Content != testReport {
+		t.Errorf("unexpected file: %+v", file)
+	}
+
+	maxBytes := *gitlab.MaxArtifactsBufferBytes
+	t.Cleanup(func() { *gitlab.MaxArtifactsBufferBytes = maxBytes })
+	*gitlab.MaxArtifactsBufferBytes = int64(len(job.Artifacts) - 1)
+
+	text, isError := callTool(ctx, t, c, "read_job_artifact", arguments)
+	if !isError || !strings.Contains(text, "the artifacts archive exceeds") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	// The temporary files are removed.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("temporary files are left: %v", entries)
+	}
+}
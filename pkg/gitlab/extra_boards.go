@@ -0,0 +1,599 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type GetBoardsParams struct {
+	Page    *int32 `json:"page,omitempty" jsonschema:"description=Current page number"`
+	PerPage *int32 `json:"per_page,omitempty" jsonschema:"description=Number of items per page"`
+}
+
+type PostBoardsBoardIdListsBody struct {
+	LabelId     *int `json:"label_id,omitempty" jsonschema:"description=The ID of a label."`
+	AssigneeId  *int `json:"assignee_id,omitempty" jsonschema:"description=The ID of a user."`
+	MilestoneId *int `json:"milestone_id,omitempty" jsonschema:"description=The ID of a milestone."`
+	IterationId *int `json:"iteration_id,omitempty" jsonschema:"description=The ID of an iteration."`
+}
+
+type PutBoardsBoardIdListsListIdBody struct {
+	Position int `json:"position" jsonschema:"description=The position of the list."`
+}
+
+type GetProjectsIdBoardsRequest struct {
+	Id     string           `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Params *GetBoardsParams `json:"params,omitempty"`
+}
+
+func registerGetProjectsIdBoards(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetProjectsIdBoardsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_pjs_id_boards",
+		mcp.WithDescription("Lists project issue boards in the given project."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getProjectsIdBoardsHandler)))
+}
+
+func getProjectsIdBoardsHandler(ctx context.Context, request mcp.CallToolRequest, req GetProjectsIdBoardsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/projects/%s/boards", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, req.Params, nil))
+}
+
+type GetProjectsIdBoardsBoardIdListsRequest struct {
+	Id      string           `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	BoardId int              `json:"board_id" jsonschema:"description=The ID of a board."`
+	Params  *GetBoardsParams `json:"params,omitempty"`
+}
+
+func registerGetProjectsIdBoardsBoardIdLists(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetProjectsIdBoardsBoardIdListsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_pjs_id_boards_board_id_lists",
+		mcp.WithDescription("Get a list of the board's lists. Does not include open and closed lists."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getProjectsIdBoardsBoardIdListsHandler)))
+}
+
+func getProjectsIdBoardsBoardIdListsHandler(ctx context.Context, request mcp.CallToolRequest, req GetProjectsIdBoardsBoardIdListsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/projects/%s/boards/%s/lists", pathEscape(req.Id), pathEscape(req.BoardId))
+	return toResult(doRequest(ctx, http.MethodGet, path, req.Params, nil))
+}
+
+type PostProjectsIdBoardsBoardIdListsRequest struct {
+	Id      string                     `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	BoardId int                        `json:"board_id" jsonschema:"description=The ID of a board."`
+	Body    PostBoardsBoardIdListsBody `json:"body"`
+}
+
+func registerPostProjectsIdBoardsBoardIdLists(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PostProjectsIdBoardsBoardIdListsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("post_pjs_id_boards_board_id_lists",
+		mcp.WithDescription("Creates a new issue board list. Specify only one of label_id, assignee_id, milestone_id or iteration_id."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(postProjectsIdBoardsBoardIdListsHandler)))
+}
+
+func postProjectsIdBoardsBoardIdListsHandler(ctx context.Context, request mcp.CallToolRequest, req PostProjectsIdBoardsBoardIdListsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/projects/%s/boards/%s/lists", pathEscape(req.Id), pathEscape(req.BoardId))
+	return toResult(doRequest(ctx, http.MethodPost, path, nil, req.Body))
+}
+
+type PutProjectsIdBoardsBoardIdListsListIdRequest struct {
+	Id      string                          `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	BoardId int                             `json:"board_id" jsonschema:"description=The ID of a board."`
+	ListId  int                             `json:"list_id" jsonschema:"description=The ID of a board's list."`
+	Body    PutBoardsBoardIdListsListIdBody `json:"body"`
+}
+
+func registerPutProjectsIdBoardsBoardIdListsListId(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PutProjectsIdBoardsBoardIdListsListIdRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("put_pjs_id_boards_board_id_lists_list_id",
+		mcp.WithDescription("Updates an existing issue board list. This call is used to change list position."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(putProjectsIdBoardsBoardIdListsListIdHandler)))
+}
+
+func putProjectsIdBoardsBoardIdListsListIdHandler(ctx context.Context, request mcp.CallToolRequest, req PutProjectsIdBoardsBoardIdListsListIdRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/projects/%s/boards/%s/lists/%s", pathEscape(req.Id), pathEscape(req.BoardId), pathEscape(req.ListId))
+	return toResult(doRequest(ctx, http.MethodPut, path, nil, req.Body))
+}
+
+type GetGroupsIdBoardsRequest struct {
+	Id     string           `json:"id" jsonschema:"description=The ID or URL-encoded path of the group."`
+	Params *GetBoardsParams `json:"params,omitempty"`
+}
+
+func registerGetGroupsIdBoards(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetGroupsIdBoardsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_grps_id_boards",
+		mcp.WithDescription("Lists issue boards in the given group."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getGroupsIdBoardsHandler)))
+}
+
+func getGroupsIdBoardsHandler(ctx context.Context, request mcp.CallToolRequest, req GetGroupsIdBoardsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/groups/%s/boards", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, req.Params, nil))
+}
+
+type GetGroupsIdBoardsBoardIdListsRequest struct {
+	Id      string           `json:"id" jsonschema:"description=The ID or URL-encoded path of the group."`
+	BoardId int              `json:"board_id" jsonschema:"description=The ID of a board."`
+	Params  *GetBoardsParams `json:"params,omitempty"`
+}
+
+func registerGetGroupsIdBoardsBoardIdLists(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetGroupsIdBoardsBoardIdListsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_grps_id_boards_board_id_lists",
+		mcp.WithDescription("Get a list of the board's lists. Does not include open and closed lists."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getGroupsIdBoardsBoardIdListsHandler)))
+}
+
+func getGroupsIdBoardsBoardIdListsHandler(ctx context.Context, request mcp.CallToolRequest, req GetGroupsIdBoardsBoardIdListsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/groups/%s/boards/%s/lists", pathEscape(req.Id), pathEscape(req.BoardId))
+	return toResult(doRequest(ctx, http.MethodGet, path, req.Params, nil))
+}
+
+type PostGroupsIdBoardsBoardIdListsRequest struct {
+	Id      string                     `json:"id" jsonschema:"description=The ID or URL-encoded path of the group."`
+	BoardId int                        `json:"board_id" jsonschema:"description=The ID of a board."`
+	Body    PostBoardsBoardIdListsBody `json:"body"`
+}
+
+func registerPostGroupsIdBoardsBoardIdLists(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PostGroupsIdBoardsBoardIdListsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("post_grps_id_boards_board_id_lists",
+		mcp.WithDescription("Creates a new issue board list. Specify only one of label_id, assignee_id, milestone_id or iteration_id."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(postGroupsIdBoardsBoardIdListsHandler)))
+}
+
+func postGroupsIdBoardsBoardIdListsHandler(ctx context.Context, request mcp.CallToolRequest, req PostGroupsIdBoardsBoardIdListsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/groups/%s/boards/%s/lists", pathEscape(req.Id), pathEscape(req.BoardId))
+	return toResult(doRequest(ctx, http.MethodPost, path, nil, req.Body))
+}
+
+type PutGroupsIdBoardsBoardIdListsListIdRequest struct {
+	Id      string                          `json:"id" jsonschema:"description=The ID or URL-encoded path of the group."`
+	BoardId int                             `json:"board_id" jsonschema:"description=The ID of a board."`
+	ListId  int                             `json:"list_id" jsonschema:"description=The ID of a board's list."`
+	Body    PutBoardsBoardIdListsListIdBody `json:"body"`
+}
+
+func registerPutGroupsIdBoardsBoardIdListsListId(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PutGroupsIdBoardsBoardIdListsListIdRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("put_grps_id_boards_board_id_lists_list_id",
+		mcp.WithDescription("Updates an existing issue board list. This call is used to change list position."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(putGroupsIdBoardsBoardIdListsListIdHandler)))
+}
+
+func putGroupsIdBoardsBoardIdListsListIdHandler(ctx context.Context, request mcp.CallToolRequest, req PutGroupsIdBoardsBoardIdListsListIdRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/groups/%s/boards/%s/lists/%s", pathEscape(req.Id), pathEscape(req.BoardId), pathEscape(req.ListId))
+	return toResult(doRequest(ctx, http.MethodPut, path, nil, req.Body))
+}
+
+type boardLabel struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type boardUser struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type boardMilestone struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+type boardList struct {
+	Id        int             `json:"id"`
+	ListType  string          `json:"list_type"`
+	Position  int             `json:"position"`
+	Label     *boardLabel     `json:"label"`
+	Assignee  *boardUser      `json:"assignee"`
+	Milestone *boardMilestone `json:"milestone"`
+}
+
+// boardScope is the scope of a board which filters the issues of all lists.
+type boardScope struct {
+	Milestone *boardMilestone `json:"milestone"`
+	Assignee  *boardUser      `json:"assignee"`
+	Labels    []boardLabel    `json:"labels"`
+	Weight    *int            `json:"weight"`
+}
+
+// scope returns the query parameters of the issues API filtering the issues by the scope.
+// The labels are returned by labelNames to be merged with the label of a list.
+func (b boardScope) scope() map[string]any {
+	params := map[string]any{}
+	if len(b.Labels) != 0 {
+		params["labels"] = strings.Join(b.labelNames(), ",")
+	}
+
+	if b.Milestone != nil {
+		// The board scoped to no milestone has the milestone titled 'No Milestone'.
+		if b.Milestone.Title == "No Milestone" {
+			params["milestone"] = "None"
+		} else {
+			params["milestone"] = b.Milestone.Title
+		}
+	}
+
+	if b.Assignee != nil {
+		params["assignee_username"] = b.Assignee.Username
+	}
+
+	// The negative weights are the special values such as any weight.
+	if b.Weight != nil && *b.Weight >= 0 {
+		params["weight"] = *b.Weight
+	}
+
+	return params
+}
+
+func (b boardScope) labelNames() []string {
+	names := []string{}
+	for _, label := range b.Labels {
+		names = append(names, label.Name)
+	}
+
+	return names
+}
+
+type boardIssue struct {
+	Iid       int      `json:"iid"`
+	ProjectId int      `json:"project_id"`
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	Labels    []string `json:"labels"`
+	Assignees []string `json:"assignees,omitempty"`
+	WebUrl    string   `json:"web_url"`
+}
+
+type boardIssueResponse struct {
+	boardIssue
+	Assignees []boardUser `json:"assignees"`
+}
+
+type boardListIssues struct {
+	Id       int          `json:"id"`
+	ListType string       `json:"list_type"`
+	Position int          `json:"position"`
+	Name     string       `json:"name"`
+	Issues   []boardIssue `json:"issues"`
+}
+
+func boardPath(projectId string, groupId string, boardId int) (string, error) {
+	switch {
+	case projectId != "" && groupId != "":
+		return "", fmt.Errorf("specify only one of project_id or group_id")
+	case projectId != "":
+		return fmt.Sprintf("/projects/%s/boards/%s", pathEscape(projectId), pathEscape(boardId)), nil
+	case groupId != "":
+		return fmt.Sprintf("/groups/%s/boards/%s", pathEscape(groupId), pathEscape(boardId)), nil
+	default:
+		return "", fmt.Errorf("missing project_id or group_id")
+	}
+}
+
+func getBoardLists(ctx context.Context, path string) ([]boardList, error) {
+	var lists []boardList
+	params := map[string]any{"per_page": 100}
+	if err := requestJSON(ctx, http.MethodGet, path+"/lists", params, nil, &lists); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+type GetBoardIssuesRequest struct {
+	ProjectId string `json:"project_id,omitempty" jsonschema:"description=The ID or URL-encoded path of the project. Specify it for a project board."`
+	GroupId   string `json:"group_id,omitempty" jsonschema:"description=The ID or URL-encoded path of the group. Specify it for a group board."`
+	BoardId   int    `json:"board_id" jsonschema:"description=The ID of a board."`
+	PerList   *int32 `json:"per_list,omitempty" jsonschema:"description=Maximum number of issues per list. Default is 20.,minimum=1,maximum=100"`
+}
+
+func registerGetBoardIssues(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetBoardIssuesRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_board_issues",
+		mcp.WithDescription("Get the lists of an issue board with their open issues in board order. The issues are filtered by the scope of the board such as the milestone and the labels."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getBoardIssuesHandler)))
+}
+
+func getBoardIssuesHandler(ctx context.Context, request mcp.CallToolRequest, req GetBoardIssuesRequest) (*mcp.CallToolResult, error) {
+	path, err := boardPath(req.ProjectId, req.GroupId, req.BoardId)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var board boardScope
+	if err := requestJSON(ctx, http.MethodGet, path, nil, nil, &board); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	lists, err := getBoardLists(ctx, path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	issuesPath := fmt.Sprintf("/projects/%s/issues", pathEscape(req.ProjectId))
+	if req.GroupId != "" {
+		issuesPath = fmt.Sprintf("/groups/%s/issues", pathEscape(req.GroupId))
+	}
+
+	perList := int32(20)
+	if req.PerList != nil {
+		perList = *req.PerList
+	}
+
+	result := make([]boardListIssues, len(lists))
+	errs := make([]error, len(lists))
+	forEachConcurrently(len(lists), func(i int) {
+		list := lists[i]
+		result[i] = boardListIssues{
+			Id:       list.Id,
+			ListType: list.ListType,
+			Position: list.Position,
+			Issues:   []boardIssue{},
+		}
+
+		params := board.scope()
+		params["state"] = "opened"
+		params["order_by"] = "relative_position"
+		params["sort"] = "asc"
+		params["per_page"] = perList
+
+		switch {
+		case list.Label != nil:
+			result[i].Name = list.Label.Name
+			params["labels"] = strings.Join(append(board.labelNames(), list.Label.Name), ",")
+		case list.Assignee != nil:
+			result[i].Name = list.Assignee.Username
+			params["assignee_username"] = list.Assignee.Username
+		case list.Milestone != nil:
+			result[i].Name = list.Milestone.Title
+			params["milestone"] = list.Milestone.Title
+		default:
+			// Iteration lists and others are returned without issues.
+			return
+		}
+
+		var issues []boardIssueResponse
+		if err := requestJSON(ctx, http.MethodGet, issuesPath, params, nil, &issues); err != nil {
+			errs[i] = err
+			return
+		}
+
+		for _, issue := range issues {
+			item := issue.boardIssue
+			for _, assignee := range issue.Assignees {
+				item.Assignees = append(item.Assignees, assignee.Username)
+			}
+			result[i].Issues = append(result[i].Issues, item)
+		}
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return toJSONResult(result)
+}
+
+type MoveBoardIssueRequest struct {
+	Id         string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project of the issue."`
+	IssueIid   int    `json:"issue_iid" jsonschema:"description=The internal ID of a project's issue."`
+	GroupId    string `json:"group_id,omitempty" jsonschema:"description=The ID or URL-encoded path of the group. Specify it when the board is a group board."`
+	BoardId    int    `json:"board_id" jsonschema:"description=The ID of a board."`
+	ToListId   int    `json:"to_list_id" jsonschema:"description=The ID of the board's list to move the issue to."`
+	FromListId *int   `json:"from_list_id,omitempty" jsonschema:"description=The ID of the board's list to move the issue from. If omitted, the issue is removed from all other label lists of the board."`
+}
+
+func registerMoveBoardIssue(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&MoveBoardIssueRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("move_board_issue",
+		mcp.WithDescription("Move an issue between label lists of an issue board by swapping the list labels."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(moveBoardIssueHandler)))
+}
+
+func moveBoardIssueHandler(ctx context.Context, request mcp.CallToolRequest, req MoveBoardIssueRequest) (*mcp.CallToolResult, error) {
+	projectId := req.Id
+	if req.GroupId != "" {
+		projectId = ""
+	}
+
+	path, err := boardPath(projectId, req.GroupId, req.BoardId)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	lists, err := getBoardLists(ctx, path)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	addLabel := ""
+	removeLabels := []string{}
+	for _, list := range lists {
+		if list.Label == nil {
+			continue
+		}
+
+		switch {
+		case list.Id == req.ToListId:
+			addLabel = list.Label.Name
+		case req.FromListId == nil || list.Id == *req.FromListId:
+			removeLabels = append(removeLabels, list.Label.Name)
+		default:
+		}
+	}
+
+	if addLabel == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("label list %d is not found in board %d", req.ToListId, req.BoardId)), nil
+	}
+
+	if req.FromListId != nil && len(removeLabels) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("label list %d is not found in board %d", *req.FromListId, req.BoardId)), nil
+	}
+
+	body := map[string]any{
+		"add_labels":    addLabel,
+		"remove_labels": removeLabels,
+	}
+
+	issuePath := fmt.Sprintf("/projects/%s/issues/%s", pathEscape(req.Id), pathEscape(req.IssueIid))
+	return toResult(doRequest(ctx, http.MethodPut, issuePath, nil, body))
+}
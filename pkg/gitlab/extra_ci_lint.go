@@ -0,0 +1,246 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// lintLocationPattern matches the location in a YAML syntax error message.
+var lintLocationPattern = regexp.MustCompile(`at line (\d+) column (\d+)`)
+
+// lintKeyPattern matches the key path at the beginning of a message such as
+// 'jobs:test:script config ...' or 'root config ...'.
+var lintKeyPattern = regexp.MustCompile(`^(?:(jobs:[^\s:]+(?::[a-z_]+)*) |(root) config )`)
+
+type ciLintJob struct {
+	Name         string          `json:"name"`
+	Stage        string          `json:"stage"`
+	When         string          `json:"when"`
+	AllowFailure bool            `json:"allow_failure"`
+	Needs        json.RawMessage `json:"needs"`
+}
+
+type ciLintResult struct {
+	Valid      bool        `json:"valid"`
+	Errors     []string    `json:"errors"`
+	Warnings   []string    `json:"warnings"`
+	MergedYaml string      `json:"merged_yaml"`
+	Jobs       []ciLintJob `json:"jobs"`
+}
+
+type ciLintMessage struct {
+	Message string `json:"message"`
+	// Location is the key path of the configuration (e.g. 'jobs:test:script').
+	Location string `json:"location,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+}
+
+type ciGraphJob struct {
+	Name         string   `json:"name"`
+	Needs        []string `json:"needs"`
+	When         string   `json:"when,omitempty"`
+	AllowFailure bool     `json:"allow_failure,omitempty"`
+}
+
+type ciGraphStage struct {
+	Name string       `json:"name"`
+	Jobs []ciGraphJob `json:"jobs"`
+}
+
+// lintMessages converts the messages of the lint result to the structured messages.
+func lintMessages(messages []string) []ciLintMessage {
+	result := []ciLintMessage{}
+	for _, message := range messages {
+		m := ciLintMessage{Message: message}
+
+		// e.g. 'jobs:test config contains unknown keys: foo'
+		if match := lintKeyPattern.FindStringSubmatch(message); match != nil {
+			m.Location = match[1] + match[2]
+		}
+
+		// e.g. '(<unknown>): did not find expected key while parsing a block mapping at line 3 column 1'
+		if match := lintLocationPattern.FindStringSubmatch(message); match != nil {
+			m.Line, _ = strconv.Atoi(match[1])
+			m.Column, _ = strconv.Atoi(match[2])
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+// jobNeeds returns the names of the needed jobs.
+// The lint result represents needs as names, objects with a name, or an object keyed by 'job'.
+func jobNeeds(raw json.RawMessage) []string {
+	needs := []string{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return needs
+	}
+
+	var names []string
+	if err := json.Unmarshal(raw, &names); err == nil {
+		return append(needs, names...)
+	}
+
+	type need struct {
+		Name string `json:"name"`
+		Job  string `json:"job"`
+	}
+
+	var objects []need
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		var grouped map[string][]need
+		if err := json.Unmarshal(raw, &grouped); err != nil {
+			return needs
+		}
+
+		objects = grouped["job"]
+	}
+
+	for _, n := range objects {
+		if n.Name != "" {
+			needs = append(needs, n.Name)
+		} else if n.Job != "" {
+			needs = append(needs, n.Job)
+		}
+	}
+
+	return needs
+}
+
+// jobGraph groups the jobs by stage in the order of the lint result.
+func jobGraph(jobs []ciLintJob) []*ciGraphStage {
+	stages := []*ciGraphStage{}
+	byName := map[string]*ciGraphStage{}
+	for _, job := range jobs {
+		stage, ok := byName[job.Stage]
+		if !ok {
+			stage = &ciGraphStage{Name: job.Stage, Jobs: []ciGraphJob{}}
+			byName[job.Stage] = stage
+			stages = append(stages, stage)
+		}
+
+		stage.Jobs = append(stage.Jobs, ciGraphJob{
+			Name:         job.Name,
+			Needs:        jobNeeds(job.Needs),
+			When:         job.When,
+			AllowFailure: job.AllowFailure,
+		})
+	}
+
+	return stages
+}
+
+// rawFile returns the raw content of the file at the ref.
+func rawFile(ctx context.Context, id string, ref string, name string) (string, error) {
+	filePath := fmt.Sprintf("/projects/%s/repository/files/%s/raw", pathEscape(id), pathEscape(name))
+	response, err := doRequest(ctx, http.MethodGet, filePath, map[string]any{"ref": ref}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := readResponse(response)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+type LintCiConfigRequest struct {
+	Id                string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Content           string `json:"content,omitempty" jsonschema:"description=The CI/CD configuration content in YAML. Specify either content or path."`
+	Path              string `json:"path,omitempty" jsonschema:"description=Path of the CI/CD configuration file in the repository. Default is .gitlab-ci.yml if content is not specified."`
+	Ref               string `json:"ref,omitempty" jsonschema:"description=The branch or tag to read the file from and to run the pipeline simulation in. Default is the default branch."`
+	ExcludeMergedYaml bool   `json:"exclude_merged_yaml,omitempty" jsonschema:"description=Omit the merged YAML from the result."`
+}
+
+func registerLintCiConfig(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&LintCiConfigRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("lint_ci_config",
+		mcp.WithDescription("Validate a CI/CD configuration given as YAML content or a file path at a ref. The pipeline creation is simulated with includes expanded. Returns errors and warnings with their locations, the merged YAML and the graph of stages, jobs and needs."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(lintCiConfigHandler)))
+}
+
+func lintCiConfigHandler(ctx context.Context, request mcp.CallToolRequest, req LintCiConfigRequest) (*mcp.CallToolResult, error) {
+	if req.Content != "" && req.Path != "" {
+		return mcp.NewToolResultError("specify only one of content or path"), nil
+	}
+
+	ref := req.Ref
+	if ref == "" {
+		branch, err := defaultBranch(ctx, req.Id)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		ref = branch
+	}
+
+	content := req.Content
+	if content == "" {
+		name := req.Path
+		if name == "" {
+			name = ".gitlab-ci.yml"
+		}
+
+		text, err := rawFile(ctx, req.Id, ref, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: %s", name, err.Error())), nil
+		}
+
+		content = text
+	}
+
+	body := map[string]any{
+		"content":      content,
+		"dry_run":      true,
+		"dry_run_ref":  ref,
+		"include_jobs": true,
+	}
+
+	var lint ciLintResult
+	lintPath := fmt.Sprintf("/projects/%s/ci/lint", pathEscape(req.Id))
+	if err := requestJSON(ctx, http.MethodPost, lintPath, nil, body, &lint); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result := map[string]any{
+		"valid":    lint.Valid,
+		"ref":      ref,
+		"errors":   lintMessages(lint.Errors),
+		"warnings": lintMessages(lint.Warnings),
+		"stages":   jobGraph(lint.Jobs),
+	}
+
+	if !req.ExcludeMergedYaml {
+		result["merged_yaml"] = lint.MergedYaml
+	}
+
+	return toJSONResult(result)
+}
@@ -0,0 +1,40 @@
+package gitlab_test
+
+import (
+	"testing"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+func TestLintMessages(t *testing.T) {
+	tests := []struct {
+		message  string
+		location string
+		line     int
+		column   int
+	}{
+		{message: "jobs:test config contains unknown keys: foo", location: "jobs:test"},
+		{message: "jobs:test:script config should be a string or a nested array of strings up to 10 levels deep", location: "jobs:test:script"},
+		{message: "jobs:build:rules:rule if invalid expression syntax", location: "jobs:build:rules:rule"},
+		{message: "root config contains unknown keys: foo", location: "root"},
+		{message: "(<unknown>): did not find expected key while parsing a block mapping at line 3 column 1", line: 3, column: 1},
+		// The prefixes which are not a key path are not a location.
+		{message: "build job: chosen stage does not exist; available stages are .pre, build, test, deploy, .post"},
+		{message: "Included file `ci/test.yml` does not have valid YAML syntax!"},
+		{message: "variables config should be a hash of key value pairs"},
+		{message: "root is not a valid key"},
+		{message: "Unknown alias: foo"},
+	}
+
+	for _, tt := range tests {
+		messages := gitlab.LintMessages([]string{tt.message})
+		if len(messages) != 1 {
+			t.Fatalf("%q: %+v", tt.message, messages)
+		}
+
+		m := messages[0]
+		if m.Message != tt.message || m.Location != tt.location || m.Line != tt.line || m.Column != tt.column {
+			t.Errorf("%q: got %+v", tt.message, m)
+		}
+	}
+}
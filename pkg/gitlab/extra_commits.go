@@ -0,0 +1,157 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type CommitAction struct {
+	Action          string `json:"action" jsonschema:"description=The action to perform.,enum=create,enum=update,enum=delete,enum=move,enum=chmod"`
+	FilePath        string `json:"file_path" jsonschema:"description=Full path to the file."`
+	PreviousPath    string `json:"previous_path,omitempty" jsonschema:"description=Original full path to the file being moved. Required for 'move' action."`
+	Content         string `json:"content,omitempty" jsonschema:"description=File content for 'create' and 'update' actions. Optional for 'move' action to change the content."`
+	Encoding        string `json:"encoding,omitempty" jsonschema:"description=Encoding of the content. Default is text.,enum=text,enum=base64"`
+	LastCommitId    string `json:"last_commit_id,omitempty" jsonschema:"description=Last known file commit ID. If the file was changed after this commit, the commit fails. Used by 'update', 'move' and 'delete' actions."`
+	ExecuteFilemode *bool  `json:"execute_filemode,omitempty" jsonschema:"description=When true/false enables/disables the execute flag on the file. Required for 'chmod' action."`
+}
+
+type commitChanges struct {
+	Branch        string         `json:"branch"`
+	CommitMessage string         `json:"commit_message"`
+	StartBranch   string         `json:"start_branch,omitempty"`
+	StartSha      string         `json:"start_sha,omitempty"`
+	Actions       []CommitAction `json:"actions"`
+	AuthorEmail   string         `json:"author_email,omitempty"`
+	AuthorName    string         `json:"author_name,omitempty"`
+	Force         bool           `json:"force,omitempty"`
+}
+
+type commitStats struct {
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+	Total     int `json:"total"`
+}
+
+type commitResult struct {
+	Id      string       `json:"id"`
+	ShortId string       `json:"short_id"`
+	Title   string       `json:"title"`
+	WebUrl  string       `json:"web_url"`
+	Stats   *commitStats `json:"stats,omitempty"`
+}
+
+func validateCommitActions(actions []CommitAction) error {
+	if len(actions) == 0 {
+		return fmt.Errorf("actions is empty")
+	}
+
+	for i, action := range actions {
+		if action.FilePath == "" {
+			return fmt.Errorf("actions[%d]: file_path is required", i)
+		}
+
+		switch action.Encoding {
+		case "", "text", "base64":
+		default:
+			return fmt.Errorf("actions[%d]: unknown encoding %q", i, action.Encoding)
+		}
+
+		switch action.Action {
+		case "create", "update":
+			// An empty content is a valid empty file.
+		case "delete":
+			if action.Content != "" {
+				return fmt.Errorf("actions[%d]: content is not allowed for delete", i)
+			}
+		case "move":
+			if action.PreviousPath == "" {
+				return fmt.Errorf("actions[%d]: previous_path is required for move", i)
+			}
+		case "chmod":
+			if action.ExecuteFilemode == nil {
+				return fmt.Errorf("actions[%d]: execute_filemode is required for chmod", i)
+			}
+		default:
+			return fmt.Errorf("actions[%d]: unknown action %q", i, action.Action)
+		}
+	}
+
+	return nil
+}
+
+// createCommit creates a commit with multiple file changes.
+func createCommit(ctx context.Context, id string, changes commitChanges) (*commitResult, error) {
+	if err := validateCommitActions(changes.Actions); err != nil {
+		return nil, err
+	}
+
+	if changes.StartBranch != "" && changes.StartSha != "" {
+		return nil, fmt.Errorf("specify only one of start_branch or start_sha")
+	}
+
+	var commit commitResult
+	path := fmt.Sprintf("/projects/%s/repository/commits", pathEscape(id))
+	if err := requestJSON(ctx, http.MethodPost, path, map[string]any{"stats": true}, changes, &commit); err != nil {
+		return nil, err
+	}
+
+	return &commit, nil
+}
+
+type CommitChangesRequest struct {
+	Id            string         `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Branch        string         `json:"branch" jsonschema:"description=Name of the branch to commit into. To create a new branch, also provide start_branch or start_sha."`
+	CommitMessage string         `json:"commit_message" jsonschema:"description=Commit message."`
+	StartBranch   string         `json:"start_branch,omitempty" jsonschema:"description=Name of the branch to start the new branch from."`
+	StartSha      string         `json:"start_sha,omitempty" jsonschema:"description=SHA of the commit to start the new branch from."`
+	Actions       []CommitAction `json:"actions" jsonschema:"description=Actions to perform in the commit. All actions are applied atomically.,minItems=1"`
+	AuthorEmail   string         `json:"author_email,omitempty" jsonschema:"description=Specify the commit author's email address."`
+	AuthorName    string         `json:"author_name,omitempty" jsonschema:"description=Specify the commit author's name."`
+	Force         bool           `json:"force,omitempty" jsonschema:"description=When true overwrites the target branch with a new commit based on the start_branch or start_sha."`
+}
+
+func registerCommitChanges(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&CommitChangesRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("commit_changes",
+		mcp.WithDescription("Create a single commit with multiple file actions (create, update, delete, move and chmod). The branch is created from start_branch or start_sha if specified."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(commitChangesHandler)))
+}
+
+func commitChangesHandler(ctx context.Context, request mcp.CallToolRequest, req CommitChangesRequest) (*mcp.CallToolResult, error) {
+	commit, err := createCommit(ctx, req.Id, commitChanges{
+		Branch:        req.Branch,
+		CommitMessage: req.CommitMessage,
+		StartBranch:   req.StartBranch,
+		StartSha:      req.StartSha,
+		Actions:       req.Actions,
+		AuthorEmail:   req.AuthorEmail,
+		AuthorName:    req.AuthorName,
+		Force:         req.Force,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return toJSONResult(commit)
+}
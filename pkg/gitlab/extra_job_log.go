@@ -0,0 +1,359 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+var (
+	ansiPattern    = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+	sectionPattern = regexp.MustCompile(`section_(start|end):(\d+):([^\s\[\r]+)(\[[^\]]*\])?\r?`)
+	errorPattern   = regexp.MustCompile(`(?i)(\b(error|errors|fatal|fail|failed|failure|failures|panic|exception|traceback|undefined reference|segmentation fault|no such file or directory|permission denied|not found)\b|npm err!|^e\s|exit (code|status) [1-9])`)
+	noErrorPattern = regexp.MustCompile(`(?i)(\b0 (errors|failures|failed)\b|\bno errors\b|\berrors?: 0\b|\bfailures?: 0\b|\bignore[_-]?errors?\b)`)
+)
+
+// jobLogSection is a section of a job log marked by 'section_start' and 'section_end'.
+type jobLogSection struct {
+	Name      string
+	Collapsed bool
+	Start     int64
+	End       int64
+	Parent    *jobLogSection
+	// FirstLine and LastLine are the 1-based line numbers in the cleaned log.
+	FirstLine int
+	LastLine  int
+}
+
+// hidden reports whether the section or one of its parents is collapsed.
+func (s *jobLogSection) hidden() bool {
+	for section := s; section != nil; section = section.Parent {
+		if section.Collapsed {
+			return true
+		}
+	}
+
+	return false
+}
+
+type jobLogLine struct {
+	Text    string
+	Section *jobLogSection
+}
+
+// jobLog is a job log without ANSI escape sequences and section markers.
+type jobLog struct {
+	Lines    []jobLogLine
+	Sections []*jobLogSection
+}
+
+// stripANSI removes ANSI escape sequences.
+func stripANSI(text string) string {
+	return ansiPattern.ReplaceAllString(text, "")
+}
+
+// parseJobLog parses the raw job log.
+// ANSI escape sequences and section markers are removed and
+// a carriage return is handled as a terminal overwriting the line.
+func parseJobLog(raw string) *jobLog {
+	result := &jobLog{Lines: []jobLogLine{}, Sections: []*jobLogSection{}}
+	stack := []*jobLogSection{}
+	for _, line := range strings.Split(strings.TrimSuffix(raw, "\n"), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		markers := sectionPattern.FindAllStringSubmatch(line, -1)
+		for _, match := range markers {
+			timestamp, _ := strconv.ParseInt(match[2], 10, 64)
+			if match[1] == "start" {
+				section := &jobLogSection{
+					Name:      match[3],
+					Collapsed: strings.Contains(match[4], "collapsed=true"),
+					Start:     timestamp,
+					FirstLine: len(result.Lines) + 1,
+					LastLine:  len(result.Lines),
+				}
+				if len(stack) > 0 {
+					section.Parent = stack[len(stack)-1]
+				}
+
+				result.Sections = append(result.Sections, section)
+				stack = append(stack, section)
+				continue
+			}
+
+			// Close the section and the unclosed sections nested in it.
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i].Name == match[3] {
+					stack[i].End = timestamp
+					stack = stack[:i]
+					break
+				}
+			}
+		}
+
+		line = sectionPattern.ReplaceAllString(line, "")
+		if i := strings.LastIndex(line, "\r"); i >= 0 {
+			line = line[i+1:]
+		}
+
+		line = stripANSI(line)
+		if line == "" && len(markers) > 0 {
+			continue
+		}
+
+		var section *jobLogSection
+		if len(stack) > 0 {
+			section = stack[len(stack)-1]
+		}
+
+		result.Lines = append(result.Lines, jobLogLine{Text: line, Section: section})
+		for s := section; s != nil; s = s.Parent {
+			s.LastLine = len(result.Lines)
+		}
+	}
+
+	return result
+}
+
+// text returns the lines of the log.
+// The lines in collapsed sections are omitted unless withCollapsed is true.
+func (l *jobLog) text(withCollapsed bool) []string {
+	lines := []string{}
+	for _, line := range l.Lines {
+		if !withCollapsed && line.Section != nil && line.Section.hidden() {
+			continue
+		}
+
+		lines = append(lines, line.Text)
+	}
+
+	return lines
+}
+
+// errorLines returns at most limit lines which look like errors.
+func errorLines(lines []string, limit int) []string {
+	found := []string{}
+	for _, line := range lines {
+		if !errorPattern.MatchString(line) || noErrorPattern.MatchString(line) {
+			continue
+		}
+
+		found = append(found, strings.TrimSpace(line))
+		if len(found) == limit {
+			break
+		}
+	}
+
+	return found
+}
+
+// tailLines returns the last n lines.
+func tailLines(lines []string, n int) []string {
+	if len(lines) <= n {
+		return lines
+	}
+
+	return lines[len(lines)-n:]
+}
+
+// lineRange is a range of line indexes from Start to End (exclusive).
+type lineRange struct {
+	Start int
+	End   int
+}
+
+// grepLines returns the ranges of the matched lines with context lines.
+// Overlapping ranges are merged.
+func grepLines(lines []string, pattern *regexp.Regexp, around int, limit int) (ranges []lineRange, matches int) {
+	ranges = []lineRange{}
+	for i, line := range lines {
+		if !pattern.MatchString(line) {
+			continue
+		}
+
+		matches++
+		r := lineRange{Start: max(0, i-around), End: min(len(lines), i+around+1)}
+		if n := len(ranges); n > 0 && r.Start <= ranges[n-1].End {
+			ranges[n-1].End = r.End
+		} else {
+			ranges = append(ranges, r)
+		}
+
+		if matches == limit {
+			break
+		}
+	}
+
+	return ranges, matches
+}
+
+type jobLogSectionInfo struct {
+	Name      string `json:"name"`
+	Parent    string `json:"parent,omitempty"`
+	Collapsed bool   `json:"collapsed,omitempty"`
+	Duration  *int64 `json:"duration,omitempty"`
+	FirstLine int    `json:"first_line"`
+	LastLine  int    `json:"last_line"`
+}
+
+func (l *jobLog) sectionInfos() []jobLogSectionInfo {
+	infos := []jobLogSectionInfo{}
+	for _, section := range l.Sections {
+		info := jobLogSectionInfo{
+			Name:      section.Name,
+			Collapsed: section.Collapsed,
+			FirstLine: section.FirstLine,
+			LastLine:  section.LastLine,
+		}
+
+		if section.Parent != nil {
+			info.Parent = section.Parent.Name
+		}
+
+		if section.End != 0 {
+			duration := section.End - section.Start
+			info.Duration = &duration
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+type GetJobLogRequest struct {
+	Id               string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	JobId            int    `json:"job_id" jsonschema:"description=The ID of a job."`
+	SectionsOnly     bool   `json:"sections_only,omitempty" jsonschema:"description=List the sections with their durations and line ranges without the log lines."`
+	Section          string `json:"section,omitempty" jsonschema:"description=Return only the lines in the section with this name (e.g. step_script)."`
+	ExcludeCollapsed bool   `json:"exclude_collapsed,omitempty" jsonschema:"description=Omit the lines in collapsed sections such as runner preparation."`
+	Grep             string `json:"grep,omitempty" jsonschema:"description=Regular expression (RE2 syntax) to search the log lines for."`
+	Context          int    `json:"context,omitempty" jsonschema:"description=Number of lines before and after each matched line.,minimum=0"`
+	MaxMatches       int    `json:"max_matches,omitempty" jsonschema:"description=Maximum number of matched lines. Default is 50.,minimum=1"`
+	Head             int    `json:"head,omitempty" jsonschema:"description=Number of lines from the beginning of the log.,minimum=0"`
+	Tail             int    `json:"tail,omitempty" jsonschema:"description=Number of lines from the end of the log. Default is 200 if neither head nor grep is specified.,minimum=0"`
+}
+
+func registerGetJobLog(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetJobLogRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_job_log",
+		mcp.WithDescription("Get a job log without ANSI escape sequences. The log is split into named sections with durations, and can be searched with grep or cut into head and tail windows. Lines are prefixed with their line numbers."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getJobLogHandler)))
+}
+
+func getJobLogHandler(ctx context.Context, request mcp.CallToolRequest, req GetJobLogRequest) (*mcp.CallToolResult, error) {
+	var pattern *regexp.Regexp
+	if req.Grep != "" {
+		p, err := regexp.Compile(req.Grep)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		pattern = p
+	}
+
+	trace, err := jobTrace(ctx, req.Id, req.JobId)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	log := parseJobLog(trace)
+	result := map[string]any{
+		"total_lines": len(log.Lines),
+		"sections":    log.sectionInfos(),
+	}
+
+	if req.SectionsOnly {
+		return toJSONResult(result)
+	}
+
+	// Select the lines and keep their line numbers.
+	numbers := []int{}
+	lines := []string{}
+	for i, line := range log.Lines {
+		if req.ExcludeCollapsed && line.Section != nil && line.Section.hidden() {
+			continue
+		}
+
+		if req.Section != "" && !inSection(line.Section, req.Section) {
+			continue
+		}
+
+		numbers = append(numbers, i+1)
+		lines = append(lines, line.Text)
+	}
+
+	var ranges []lineRange
+	switch {
+	case pattern != nil:
+		maxMatches := req.MaxMatches
+		if maxMatches <= 0 {
+			maxMatches = 50
+		}
+
+		var matches int
+		ranges, matches = grepLines(lines, pattern, req.Context, maxMatches)
+		result["matches"] = matches
+	case req.Head > 0 || req.Tail > 0:
+		if req.Head > 0 {
+			ranges = append(ranges, lineRange{Start: 0, End: min(len(lines), req.Head)})
+		}
+
+		if req.Tail > 0 {
+			start := max(0, len(lines)-req.Tail)
+			if n := len(ranges); n > 0 && start <= ranges[n-1].End {
+				ranges[n-1].End = len(lines)
+			} else {
+				ranges = append(ranges, lineRange{Start: start, End: len(lines)})
+			}
+		}
+	default:
+		ranges = []lineRange{{Start: max(0, len(lines)-200), End: len(lines)}}
+	}
+
+	content := []string{}
+	for i, r := range ranges {
+		if i > 0 {
+			content = append(content, "--")
+		}
+
+		for j := r.Start; j < r.End; j++ {
+			content = append(content, fmt.Sprintf("%d\t%s", numbers[j], lines[j]))
+		}
+	}
+
+	result["content"] = strings.Join(content, "\n")
+	return toJSONResult(result)
+}
+
+func inSection(section *jobLogSection, name string) bool {
+	for s := section; s != nil; s = s.Parent {
+		if s.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
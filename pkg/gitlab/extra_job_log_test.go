@@ -0,0 +1,192 @@
+package gitlab_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+// testTrace is a job log with a collapsed section, a collapsed section nested in
+// the unterminated 'step_script' section and ANSI escape sequences.
+const testTrace = "\x1b[0Ksection_start:100:prepare_executor[collapsed=true]\r\x1b[0K\x1b[32;1mPreparing the executor\x1b[0;m\n" +
+	"Using docker executor\n" +
+	"\x1b[0Ksection_end:105:prepare_executor\r\x1b[0K\n" +
+	"\x1b[0Ksection_start:105:step_script\r\x1b[0K\x1b[32;1mExecuting step_script\x1b[0;m\n" +
+	"\x1b[0Ksection_start:106:install[collapsed=true]\r\x1b[0K$ go mod download\n" +
+	"downloading\r\x1b[Kdownloaded\n" +
+	"\x1b[0Ksection_end:110:install\r\x1b[0K\n" +
+	"$ go test ./...\n" +
+	"\x1b[31;1m--- FAIL: TestX\x1b[0;m\n" +
+	"FAIL\n" +
+	"\x1b[31;1mERROR: Job failed: exit code 1\x1b[0;m\n"
+
+type jobLogSection struct {
+	Name      string `json:"name"`
+	Parent    string `json:"parent"`
+	Collapsed bool   `json:"collapsed"`
+	Duration  *int64 `json:"duration"`
+	FirstLine int    `json:"first_line"`
+	LastLine  int    `json:"last_line"`
+}
+
+type jobLog struct {
+	TotalLines int             `json:"total_lines"`
+	Sections   []jobLogSection `json:"sections"`
+	Content    string          `json:"content"`
+	Matches    int             `json:"matches"`
+}
+
+func newTestJobLog(t *testing.T) (*gitlabtest.Server, int) {
+	t.Helper()
+
+	fake, project := newFakeProject(t)
+	pipeline := fake.AddPipeline(project.Id, gitlabtest.Pipeline{Ref: "main", Sha: "abc", Status: "failed"})
+	job := fake.AddJob(pipeline.Id, gitlabtest.Job{Name: "test", Stage: "test", Status: "failed", Trace: testTrace})
+	return fake, job.Id
+}
+
+func TestJobLogSections(t *testing.T) {
+	fake, jobId := newTestJobLog(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	var log jobLog
+	callToolJSON(ctx, t, c, "get_job_log", map[string]any{
+		"id":            "group/project",
+		"job_id":        jobId,
+		"sections_only": true,
+	}, &log)
+
+	prepare, install := int64(5), int64(4)
+	expected := []jobLogSection{
+		{Name: "prepare_executor", Collapsed: true, Duration: &prepare, FirstLine: 1, LastLine: 2},
+		// The section which is not terminated has no duration.
+		{Name: "step_script", FirstLine: 3, LastLine: 9},
+		{Name: "install", Parent: "step_script", Collapsed: true, Duration: &install, FirstLine: 4, LastLine: 5},
+	}
+
+	if log.TotalLines != 9 || log.Content != "" {
+		t.Errorf("unexpected log: %+v", log)
+	}
+
+	if !reflect.DeepEqual(log.Sections, expected) {
+		t.Errorf("got %+v, want %+v", log.Sections, expected)
+	}
+}
+
+func TestJobLogWindows(t *testing.T) {
+	fake, jobId := newTestJobLog(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	lines := []string{
+		"1\tPreparing the executor",
+		"2\tUsing docker executor",
+		"3\tExecuting step_script",
+		"4\t$ go mod download",
+		"5\tdownloaded",
+		"6\t$ go test ./...",
+		"7\t--- FAIL: TestX",
+		"8\tFAIL",
+		"9\tERROR: Job failed: exit code 1",
+	}
+
+	join := func(windows ...[]string) string {
+		texts := []string{}
+		for _, window := range windows {
+			texts = append(texts, strings.Join(window, "\n"))
+		}
+
+		return strings.Join(texts, "\n--\n")
+	}
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		content   string
+		matches   int
+	}{
+		{
+			name:      "default tail",
+			arguments: map[string]any{},
+			content:   join(lines),
+		},
+		{
+			name:      "exclude collapsed",
+			arguments: map[string]any{"exclude_collapsed": true},
+			content:   join(append([]string{lines[2]}, lines[5:]...)),
+		},
+		{
+			name:      "nested section",
+			arguments: map[string]any{"section": "install"},
+			content:   join(lines[3:5]),
+		},
+		{
+			name:      "unterminated section",
+			arguments: map[string]any{"section": "step_script"},
+			content:   join(lines[2:]),
+		},
+		{
+			name:      "grep windows at start and end",
+			arguments: map[string]any{"grep": "Preparing|exit code", "context": 2},
+			content:   join(lines[:3], lines[6:]),
+			matches:   2,
+		},
+		{
+			name:      "grep overlapping windows",
+			arguments: map[string]any{"grep": "FAIL", "context": 1},
+			content:   join(lines[5:]),
+			matches:   2,
+		},
+		{
+			name:      "grep window wider than log",
+			arguments: map[string]any{"grep": "go mod", "context": 100},
+			content:   join(lines),
+			matches:   1,
+		},
+		{
+			name:      "grep max matches",
+			arguments: map[string]any{"grep": "FAIL", "max_matches": 1},
+			content:   join(lines[6:7]),
+			matches:   1,
+		},
+		{
+			name:      "grep no match",
+			arguments: map[string]any{"grep": "panic"},
+			content:   "",
+		},
+		{
+			name:      "head and tail",
+			arguments: map[string]any{"head": 2, "tail": 2},
+			content:   join(lines[:2], lines[7:]),
+		},
+		{
+			name:      "overlapping head and tail",
+			arguments: map[string]any{"head": 5, "tail": 5},
+			content:   join(lines),
+		},
+		{
+			name:      "head in section",
+			arguments: map[string]any{"section": "step_script", "head": 2},
+			content:   join(lines[2:4]),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.arguments["id"] = "group/project"
+			tt.arguments["job_id"] = jobId
+
+			var log jobLog
+			callToolJSON(ctx, t, c, "get_job_log", tt.arguments, &log)
+
+			if log.Content != tt.content {
+				t.Errorf("got\n%s\nwant\n%s", log.Content, tt.content)
+			}
+
+			if log.Matches != tt.matches {
+				t.Errorf("matches = %d, want %d", log.Matches, tt.matches)
+			}
+		})
+	}
+}
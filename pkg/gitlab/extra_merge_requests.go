@@ -0,0 +1,522 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// userIds resolves the usernames to the user IDs.
+func userIds(ctx context.Context, usernames []string) ([]int, error) {
+	ids := []int{}
+	for _, username := range usernames {
+		var users []struct {
+			Id int `json:"id"`
+		}
+
+		params := map[string]any{"username": strings.TrimPrefix(username, "@")}
+		if err := requestJSON(ctx, http.MethodGet, "/users", params, nil, &users); err != nil {
+			return nil, err
+		}
+
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %s is not found", username)
+		}
+
+		ids = append(ids, users[0].Id)
+	}
+
+	return ids, nil
+}
+
+type ProposeChangeRequest struct {
+	Id                 string         `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Branch             string         `json:"branch" jsonschema:"description=Name of the new branch."`
+	Ref                string         `json:"ref,omitempty" jsonschema:"description=Branch name or commit SHA to create the branch from. Default is the target branch."`
+	TargetBranch       string         `json:"target_branch,omitempty" jsonschema:"description=The target branch of the merge request. Default is the default branch."`
+	CommitMessage      string         `json:"commit_message,omitempty" jsonschema:"description=Commit message. Default is the title."`
+	Actions            []CommitAction `json:"actions" jsonschema:"description=File actions to commit to the new branch.,minItems=1"`
+	Title              string         `json:"title" jsonschema:"description=The title of the merge request."`
+	Description        string         `json:"description,omitempty" jsonschema:"description=Description of the merge request."`
+	Labels             []string       `json:"labels,omitempty" jsonschema:"description=Labels of the merge request."`
+	ReviewerIds        []int          `json:"reviewer_ids,omitempty" jsonschema:"description=The IDs of the users to add as reviewers."`
+	ReviewerUsernames  []string       `json:"reviewer_usernames,omitempty" jsonschema:"description=The usernames of the users to add as reviewers."`
+	AssigneeIds        []int          `json:"assignee_ids,omitempty" jsonschema:"description=The IDs of the users to assign the merge request to."`
+	RemoveSourceBranch bool           `json:"remove_source_branch,omitempty" jsonschema:"description=Flag indicating if a merge request should remove the source branch when merging."`
+	Squash             bool           `json:"squash,omitempty" jsonschema:"description=Squash commits into a single commit when merging."`
+}
+
+func registerProposeChange(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&ProposeChangeRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("propose_change",
+		mcp.WithDescription("Create a branch, commit file changes to it and open a merge request in one step. The created branch is deleted if a later step fails."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(proposeChangeHandler)))
+}
+
+func proposeChangeHandler(ctx context.Context, request mcp.CallToolRequest, req ProposeChangeRequest) (*mcp.CallToolResult, error) {
+	if req.Branch == "" || req.Title == "" {
+		return mcp.NewToolResultError("branch and title are required"), nil
+	}
+
+	// Validate and resolve all inputs before any change is made.
+	if err := validateCommitActions(req.Actions); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	reviewerIds, err := userIds(ctx, req.ReviewerUsernames)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	reviewerIds = append(reviewerIds, req.ReviewerIds...)
+
+	targetBranch := req.TargetBranch
+	if targetBranch == "" {
+		branch, err := defaultBranch(ctx, req.Id)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		targetBranch = branch
+	}
+
+	ref := req.Ref
+	if ref == "" {
+		ref = targetBranch
+	}
+
+	branchesPath := fmt.Sprintf("/projects/%s/repository/branches", pathEscape(req.Id))
+	branchParams := map[string]any{"branch": req.Branch, "ref": ref}
+	if err := requestJSON(ctx, http.MethodPost, branchesPath, branchParams, nil, nil); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("create branch: %v", err)), nil
+	}
+
+	rollback := func(cause error) (*mcp.CallToolResult, error) {
+		// Delete the branch even if the request is canceled.
+		branchPath := fmt.Sprintf("%s/%s", branchesPath, pathEscape(req.Branch))
+		if err := requestJSON(context.WithoutCancel(ctx), http.MethodDelete, branchPath, nil, nil, nil); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%v (delete branch %s: %v)", cause, req.Branch, err)), nil
+		}
+
+		return mcp.NewToolResultError(fmt.Sprintf("%v (branch %s is deleted)", cause, req.Branch)), nil
+	}
+
+	commitMessage := req.CommitMessage
+	if commitMessage == "" {
+		commitMessage = req.Title
+	}
+
+	commit, err := createCommit(ctx, req.Id, commitChanges{
+		Branch:        req.Branch,
+		CommitMessage: commitMessage,
+		Actions:       req.Actions,
+	})
+	if err != nil {
+		return rollback(fmt.Errorf("commit: %w", err))
+	}
+
+	body := map[string]any{
+		"source_branch":        req.Branch,
+		"target_branch":        targetBranch,
+		"title":                req.Title,
+		"description":          req.Description,
+		"labels":               strings.Join(req.Labels, ","),
+		"reviewer_ids":         reviewerIds,
+		"assignee_ids":         req.AssigneeIds,
+		"remove_source_branch": req.RemoveSourceBranch,
+		"squash":               req.Squash,
+	}
+
+	var mr struct {
+		Iid    int    `json:"iid"`
+		WebUrl string `json:"web_url"`
+	}
+
+	mrsPath := fmt.Sprintf("/projects/%s/merge_requests", pathEscape(req.Id))
+	if err := requestJSON(ctx, http.MethodPost, mrsPath, nil, body, &mr); err != nil {
+		return rollback(fmt.Errorf("create merge request: %w", err))
+	}
+
+	return toJSONResult(map[string]any{
+		"merge_request_iid": mr.Iid,
+		"web_url":           mr.WebUrl,
+		"branch":            req.Branch,
+		"target_branch":     targetBranch,
+		"commit":            commit,
+	})
+}
+
+// generatedFiles are the glob patterns of generated files and lock files
+// omitted from the review context by default.
+var generatedFiles = []string{
+	"go.sum",
+	"package-lock.json",
+	"npm-shrinkwrap.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"*.lock",
+	"*.min.js",
+	"*.min.css",
+	"*.map",
+	"*.pb.go",
+	"*_pb2.py",
+	"*.gen.go",
+	"*_generated.go",
+	"*.generated.*",
+	"**/__snapshots__/**",
+}
+
+type reviewUser struct {
+	Username string `json:"username"`
+}
+
+type reviewMergeRequest struct {
+	Iid                 int           `json:"iid"`
+	Title               string        `json:"title"`
+	Description         string        `json:"description"`
+	State               string        `json:"state"`
+	Draft               bool          `json:"draft"`
+	Author              *reviewUser   `json:"author"`
+	Assignees           []reviewUser  `json:"assignees"`
+	Reviewers           []reviewUser  `json:"reviewers"`
+	SourceBranch        string        `json:"source_branch"`
+	TargetBranch        string        `json:"target_branch"`
+	Labels              []string      `json:"labels"`
+	DetailedMergeStatus string        `json:"detailed_merge_status"`
+	HasConflicts        bool          `json:"has_conflicts"`
+	Sha                 string        `json:"sha"`
+	WebUrl              string        `json:"web_url"`
+	HeadPipeline        *pipelineInfo `json:"head_pipeline,omitempty"`
+}
+
+type pipelineInfo struct {
+	Id        int    `json:"id"`
+	ProjectId int    `json:"project_id,omitempty"`
+	Status    string `json:"status"`
+	Ref       string `json:"ref"`
+	Sha       string `json:"sha"`
+	WebUrl    string `json:"web_url"`
+}
+
+type reviewCommit struct {
+	ShortId    string `json:"short_id"`
+	Title      string `json:"title"`
+	AuthorName string `json:"author_name"`
+}
+
+type reviewIssue struct {
+	Iid    int    `json:"iid"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	WebUrl string `json:"web_url"`
+}
+
+type approvalRule struct {
+	Name              string       `json:"name"`
+	Approved          bool         `json:"approved"`
+	ApprovalsRequired int          `json:"approvals_required"`
+	ApprovedBy        []reviewUser `json:"approved_by"`
+}
+
+type approvalState struct {
+	Rules []approvalRule `json:"rules"`
+}
+
+type notePosition struct {
+	NewPath string `json:"new_path"`
+	NewLine *int   `json:"new_line"`
+	OldPath string `json:"old_path"`
+	OldLine *int   `json:"old_line"`
+}
+
+type discussionNote struct {
+	Author     reviewUser    `json:"author"`
+	Body       string        `json:"body"`
+	System     bool          `json:"system"`
+	Resolvable bool          `json:"resolvable"`
+	Resolved   bool          `json:"resolved"`
+	Position   *notePosition `json:"position"`
+}
+
+type discussion struct {
+	Id    string           `json:"id"`
+	Notes []discussionNote `json:"notes"`
+}
+
+type reviewNote struct {
+	Author string `json:"author"`
+	Body   string `json:"body"`
+}
+
+type reviewDiscussion struct {
+	Id    string       `json:"id"`
+	Path  string       `json:"path,omitempty"`
+	Line  *int         `json:"line,omitempty"`
+	Notes []reviewNote `json:"notes"`
+}
+
+type mergeRequestDiff struct {
+	OldPath       string `json:"old_path"`
+	NewPath       string `json:"new_path"`
+	NewFile       bool   `json:"new_file"`
+	RenamedFile   bool   `json:"renamed_file"`
+	DeletedFile   bool   `json:"deleted_file"`
+	GeneratedFile bool   `json:"generated_file"`
+	TooLarge      bool   `json:"too_large"`
+	Diff          string `json:"diff"`
+}
+
+type reviewFile struct {
+	OldPath   string `json:"old_path,omitempty"`
+	Path      string `json:"path"`
+	Status    string `json:"status"`
+	Diff      string `json:"diff"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+func unresolvedDiscussions(discussions []discussion) []reviewDiscussion {
+	result := []reviewDiscussion{}
+	for _, d := range discussions {
+		if len(d.Notes) == 0 || d.Notes[0].System || !d.Notes[0].Resolvable || d.Notes[0].Resolved {
+			continue
+		}
+
+		item := reviewDiscussion{Id: d.Id, Notes: []reviewNote{}}
+		if position := d.Notes[0].Position; position != nil {
+			item.Path = position.NewPath
+			item.Line = position.NewLine
+			if item.Line == nil {
+				item.Path = position.OldPath
+				item.Line = position.OldLine
+			}
+		}
+
+		for _, note := range d.Notes {
+			item.Notes = append(item.Notes, reviewNote{Author: note.Author.Username, Body: note.Body})
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func reviewFiles(diffs []mergeRequestDiff, includeGenerated bool, exclude []string, maxLines int) (files []reviewFile, omitted []string) {
+	files = []reviewFile{}
+	omitted = []string{}
+	for _, diff := range diffs {
+		if matchAnyGlob(exclude, diff.NewPath) {
+			omitted = append(omitted, diff.NewPath)
+			continue
+		}
+
+		if !includeGenerated && (diff.GeneratedFile || matchAnyGlob(generatedFiles, diff.NewPath) || isVendored(diff.NewPath)) {
+			omitted = append(omitted, diff.NewPath)
+			continue
+		}
+
+		file := reviewFile{Path: diff.NewPath, Status: "modified"}
+		switch {
+		case diff.NewFile:
+			file.Status = "added"
+		case diff.DeletedFile:
+			file.Status = "deleted"
+		case diff.RenamedFile:
+			file.Status = "renamed"
+			file.OldPath = diff.OldPath
+		default:
+		}
+
+		lines := strings.Split(strings.TrimSuffix(diff.Diff, "\n"), "\n")
+		if maxLines < len(lines) {
+			lines = lines[:maxLines]
+			file.Truncated = true
+		}
+
+		file.Diff = strings.Join(lines, "\n")
+		if diff.TooLarge {
+			file.Truncated = true
+		}
+
+		files = append(files, file)
+	}
+
+	return files, omitted
+}
+
+type GetMrReviewContextRequest struct {
+	Id               string   `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	MergeRequestIid  int      `json:"merge_request_iid" jsonschema:"description=The internal ID of the merge request."`
+	MaxDiffLines     int      `json:"max_diff_lines,omitempty" jsonschema:"description=Maximum number of diff lines per file. Default is 300.,minimum=1"`
+	MaxFiles         int      `json:"max_files,omitempty" jsonschema:"description=Maximum number of changed files. Default is 100.,minimum=1"`
+	MaxCommits       int      `json:"max_commits,omitempty" jsonschema:"description=Maximum number of commits. Default is 100.,minimum=1"`
+	IncludeGenerated bool     `json:"include_generated,omitempty" jsonschema:"description=Include generated files, lock files and vendored files in the diffs."`
+	Exclude          []string `json:"exclude,omitempty" jsonschema:"description=Glob patterns of the files to omit from the diffs."`
+}
+
+func registerGetMrReviewContext(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetMrReviewContextRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_mr_review_context",
+		mcp.WithDescription("Get everything needed to review a merge request in one document: metadata, diffs per file, commits, approval state, latest pipeline, unresolved discussions and issues closed on merge."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getMrReviewContextHandler)))
+}
+
+func getMrReviewContextHandler(ctx context.Context, request mcp.CallToolRequest, req GetMrReviewContextRequest) (*mcp.CallToolResult, error) {
+	maxDiffLines := req.MaxDiffLines
+	if maxDiffLines <= 0 {
+		maxDiffLines = 300
+	}
+
+	maxFiles := req.MaxFiles
+	if maxFiles <= 0 {
+		maxFiles = 100
+	}
+
+	maxCommits := req.MaxCommits
+	if maxCommits <= 0 {
+		maxCommits = 100
+	}
+
+	mrPath := fmt.Sprintf("/projects/%s/merge_requests/%s", pathEscape(req.Id), pathEscape(req.MergeRequestIid))
+
+	var mr reviewMergeRequest
+	var diffs []mergeRequestDiff
+	var commits []reviewCommit
+	var approvals approvalState
+	var pipelines []pipelineInfo
+	var discussions []discussion
+	var issues []reviewIssue
+
+	tasks := map[string]func() error{
+		"merge_request": func() error {
+			return requestJSON(ctx, http.MethodGet, mrPath, nil, nil, &mr)
+		},
+		"diffs": func() (err error) {
+			// One more file is requested to know whether the files are truncated.
+			diffs, err = requestPages[mergeRequestDiff](ctx, mrPath+"/diffs", nil, maxFiles+1)
+			return err
+		},
+		"commits": func() (err error) {
+			// One more commit is requested to know whether the commits are truncated.
+			commits, err = requestPages[reviewCommit](ctx, mrPath+"/commits", nil, maxCommits+1)
+			return err
+		},
+		"approvals": func() error {
+			return requestJSON(ctx, http.MethodGet, mrPath+"/approval_state", nil, nil, &approvals)
+		},
+		"pipeline": func() error {
+			return requestJSON(ctx, http.MethodGet, mrPath+"/pipelines", map[string]any{"per_page": 1}, nil, &pipelines)
+		},
+		"discussions": func() (err error) {
+			discussions, err = requestPages[discussion](ctx, mrPath+"/discussions", nil, 1000)
+			return err
+		},
+		"closes_issues": func() (err error) {
+			issues, err = requestPages[reviewIssue](ctx, mrPath+"/closes_issues", nil, 100)
+			return err
+		},
+	}
+
+	names := make([]string, 0, len(tasks))
+	for name := range tasks {
+		names = append(names, name)
+	}
+
+	errs := make([]error, len(names))
+	forEachConcurrently(len(names), func(i int) {
+		errs[i] = tasks[names[i]]()
+	})
+
+	// The other sections are optional and their errors are reported in the result.
+	failures := map[string]string{}
+	for i, name := range names {
+		if errs[i] == nil {
+			continue
+		}
+
+		if name == "merge_request" {
+			return mcp.NewToolResultError(errs[i].Error()), nil
+		}
+
+		failures[name] = errs[i].Error()
+	}
+
+	pipeline := mr.HeadPipeline
+	if pipeline == nil && len(pipelines) > 0 {
+		pipeline = &pipelines[0]
+	}
+	mr.HeadPipeline = nil
+
+	truncated := len(diffs) > maxFiles
+	if truncated {
+		diffs = diffs[:maxFiles]
+	}
+
+	commitsTruncated := len(commits) > maxCommits
+	if commitsTruncated {
+		commits = commits[:maxCommits]
+	}
+
+	files, omitted := reviewFiles(diffs, req.IncludeGenerated, req.Exclude, maxDiffLines)
+
+	result := map[string]any{
+		"merge_request":          mr,
+		"pipeline":               pipeline,
+		"approval_rules":         approvals.Rules,
+		"commits":                commits,
+		"closes_issues":          issues,
+		"unresolved_discussions": unresolvedDiscussions(discussions),
+		"files":                  files,
+		"omitted_files":          omitted,
+	}
+
+	if truncated {
+		result["files_truncated"] = true
+	}
+
+	if commitsTruncated {
+		result["commits_truncated"] = true
+	}
+
+	if len(failures) > 0 {
+		result["errors"] = failures
+	}
+
+	return toJSONResult(result)
+}
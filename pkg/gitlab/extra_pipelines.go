@@ -0,0 +1,540 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxChildPipelineDepth is the maximum depth of the downstream pipelines to follow.
+const maxChildPipelineDepth = 3
+
+type pipelineJob struct {
+	Id            int     `json:"id"`
+	Name          string  `json:"name"`
+	Stage         string  `json:"stage"`
+	Status        string  `json:"status"`
+	FailureReason string  `json:"failure_reason"`
+	AllowFailure  bool    `json:"allow_failure"`
+	Duration      float64 `json:"duration"`
+	WebUrl        string  `json:"web_url"`
+}
+
+type downstreamPipeline struct {
+	Id        int    `json:"id"`
+	ProjectId int    `json:"project_id"`
+	Status    string `json:"status"`
+	WebUrl    string `json:"web_url"`
+}
+
+type pipelineBridge struct {
+	Id                 int                 `json:"id"`
+	Name               string              `json:"name"`
+	Stage              string              `json:"stage"`
+	Status             string              `json:"status"`
+	DownstreamPipeline *downstreamPipeline `json:"downstream_pipeline"`
+}
+
+type testCase struct {
+	Name          string `json:"name"`
+	Classname     string `json:"classname"`
+	File          string `json:"file"`
+	Status        string `json:"status"`
+	SystemOutput  any    `json:"system_output"`
+	StackTrace    string `json:"stack_trace"`
+	ExecutionTime any    `json:"execution_time"`
+}
+
+type testSuite struct {
+	Name      string     `json:"name"`
+	TestCases []testCase `json:"test_cases"`
+}
+
+type testReport struct {
+	TotalCount   int         `json:"total_count"`
+	FailedCount  int         `json:"failed_count"`
+	ErrorCount   int         `json:"error_count"`
+	SuccessCount int         `json:"success_count"`
+	SkippedCount int         `json:"skipped_count"`
+	TestSuites   []testSuite `json:"test_suites"`
+}
+
+type failedJob struct {
+	pipelineJob
+	ProjectId  string   `json:"project_id"`
+	PipelineId int      `json:"pipeline_id"`
+	ErrorLines []string `json:"error_lines,omitempty"`
+	Tail       string   `json:"tail,omitempty"`
+	TraceError string   `json:"trace_error,omitempty"`
+}
+
+type failedTest struct {
+	ProjectId  string `json:"project_id"`
+	PipelineId int    `json:"pipeline_id"`
+	Suite      string `json:"suite"`
+	Classname  string `json:"classname,omitempty"`
+	Name       string `json:"name"`
+	File       string `json:"file,omitempty"`
+	Status     string `json:"status"`
+	Output     string `json:"output,omitempty"`
+}
+
+type pipelineDiagnosis struct {
+	Pipeline       pipelineInfo   `json:"pipeline"`
+	ChildPipelines []pipelineInfo `json:"child_pipelines"`
+	FailedJobs     []*failedJob   `json:"failed_jobs"`
+	FailedTests    []failedTest   `json:"failed_tests"`
+	Errors         []string       `json:"errors,omitempty"`
+}
+
+// resolvePipeline returns the pipeline by ID, or the latest pipeline for the ref.
+func resolvePipeline(ctx context.Context, id string, pipelineId int, ref string) (*pipelineInfo, error) {
+	var pipeline pipelineInfo
+	if pipelineId != 0 {
+		path := fmt.Sprintf("/projects/%s/pipelines/%s", pathEscape(id), pathEscape(pipelineId))
+		if err := requestJSON(ctx, http.MethodGet, path, nil, nil, &pipeline); err != nil {
+			return nil, err
+		}
+
+		return &pipeline, nil
+	}
+
+	var params map[string]any
+	if ref != "" {
+		params = map[string]any{"ref": ref}
+	}
+
+	path := fmt.Sprintf("/projects/%s/pipelines/latest", pathEscape(id))
+	if err := requestJSON(ctx, http.MethodGet, path, params, nil, &pipeline); err != nil {
+		return nil, err
+	}
+
+	return &pipeline, nil
+}
+
+// jobTrace returns the raw log of the job.
+func jobTrace(ctx context.Context, id string, jobId int) (string, error) {
+	path := fmt.Sprintf("/projects/%s/jobs/%s/trace", pathEscape(id), pathEscape(jobId))
+	response, err := doRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := readResponse(response)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+
+	return text[:limit] + "..."
+}
+
+// collectFailures collects the failed jobs and tests of the pipeline and its downstream pipelines.
+func collectFailures(ctx context.Context, diagnosis *pipelineDiagnosis, id string, pipelineId int, depth int) {
+	pipelinePath := fmt.Sprintf("/projects/%s/pipelines/%s", pathEscape(id), pathEscape(pipelineId))
+
+	jobs, err := requestPages[pipelineJob](ctx, pipelinePath+"/jobs", map[string]any{"scope[]": "failed"}, 1000)
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("jobs of pipeline %d: %v", pipelineId, err))
+	}
+
+	for _, job := range jobs {
+		diagnosis.FailedJobs = append(diagnosis.FailedJobs, &failedJob{
+			pipelineJob: job,
+			ProjectId:   id,
+			PipelineId:  pipelineId,
+		})
+	}
+
+	var report testReport
+	if err := requestJSON(ctx, http.MethodGet, pipelinePath+"/test_report", nil, nil, &report); err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("test report of pipeline %d: %v", pipelineId, err))
+	}
+
+	for _, suite := range report.TestSuites {
+		for _, test := range suite.TestCases {
+			if test.Status != "failed" && test.Status != "error" {
+				continue
+			}
+
+			output := test.StackTrace
+			if output == "" && test.SystemOutput != nil {
+				output = fmt.Sprint(test.SystemOutput)
+			}
+
+			diagnosis.FailedTests = append(diagnosis.FailedTests, failedTest{
+				ProjectId:  id,
+				PipelineId: pipelineId,
+				Suite:      suite.Name,
+				Classname:  test.Classname,
+				Name:       test.Name,
+				File:       test.File,
+				Status:     test.Status,
+				Output:     truncateText(output, 2000),
+			})
+		}
+	}
+
+	if maxChildPipelineDepth <= depth {
+		return
+	}
+
+	bridges, err := requestPages[pipelineBridge](ctx, pipelinePath+"/bridges", nil, 1000)
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("bridges of pipeline %d: %v", pipelineId, err))
+	}
+
+	for _, bridge := range bridges {
+		downstream := bridge.DownstreamPipeline
+		if downstream == nil {
+			continue
+		}
+
+		diagnosis.ChildPipelines = append(diagnosis.ChildPipelines, pipelineInfo{
+			Id:        downstream.Id,
+			ProjectId: downstream.ProjectId,
+			Status:    downstream.Status,
+			WebUrl:    downstream.WebUrl,
+		})
+
+		if downstream.Status != "failed" {
+			continue
+		}
+
+		collectFailures(ctx, diagnosis, fmt.Sprint(downstream.ProjectId), downstream.Id, depth+1)
+	}
+}
+
+type DiagnosePipelineRequest struct {
+	Id           string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	PipelineId   int    `json:"pipeline_id,omitempty" jsonschema:"description=The ID of the pipeline. Default is the latest pipeline for the ref."`
+	Ref          string `json:"ref,omitempty" jsonschema:"description=The branch or tag to get the latest pipeline for. Default is the default branch."`
+	TailLines    int    `json:"tail_lines,omitempty" jsonschema:"description=Number of log lines at the end of each failed job. Default is 50.,minimum=1"`
+	MaxErrors    int    `json:"max_errors,omitempty" jsonschema:"description=Maximum number of error lines per failed job. Default is 20.,minimum=1"`
+	MaxJobs      int    `json:"max_jobs,omitempty" jsonschema:"description=Maximum number of failed jobs to read the log. Default is 10.,minimum=1"`
+	AllowFailure bool   `json:"allow_failure,omitempty" jsonschema:"description=Read the log of failed jobs which are allowed to fail."`
+}
+
+func registerDiagnosePipeline(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&DiagnosePipelineRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("diagnose_pipeline",
+		mcp.WithDescription("Diagnose a failed pipeline. Returns the failed jobs including child pipelines with error lines and the end of the cleaned log, and the failed tests in the test report."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(diagnosePipelineHandler)))
+}
+
+func diagnosePipelineHandler(ctx context.Context, request mcp.CallToolRequest, req DiagnosePipelineRequest) (*mcp.CallToolResult, error) {
+	tailCount := req.TailLines
+	if tailCount == 0 {
+		tailCount = 50
+	}
+
+	maxErrors := req.MaxErrors
+	if maxErrors == 0 {
+		maxErrors = 20
+	}
+
+	maxJobs := req.MaxJobs
+	if maxJobs == 0 {
+		maxJobs = 10
+	}
+
+	pipeline, err := resolvePipeline(ctx, req.Id, req.PipelineId, req.Ref)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	diagnosis := &pipelineDiagnosis{
+		Pipeline:       *pipeline,
+		ChildPipelines: []pipelineInfo{},
+		FailedJobs:     []*failedJob{},
+		FailedTests:    []failedTest{},
+	}
+
+	collectFailures(ctx, diagnosis, req.Id, pipeline.Id, 0)
+
+	targets := []*failedJob{}
+	for _, job := range diagnosis.FailedJobs {
+		if job.AllowFailure && !req.AllowFailure {
+			continue
+		}
+
+		if len(targets) == maxJobs {
+			break
+		}
+
+		targets = append(targets, job)
+	}
+
+	forEachConcurrently(len(targets), func(i int) {
+		job := targets[i]
+		trace, err := jobTrace(ctx, job.ProjectId, job.Id)
+		if err != nil {
+			job.TraceError = err.Error()
+			return
+		}
+
+		lines := parseJobLog(trace).text(false)
+		job.ErrorLines = errorLines(lines, maxErrors)
+		job.Tail = strings.Join(tailLines(lines, tailCount), "\n")
+	})
+
+	return toJSONResult(diagnosis)
+}
+
+// finishedStatuses are the statuses of a pipeline or a job which do not change without user action.
+var finishedStatuses = map[string]bool{
+	"success":  true,
+	"failed":   true,
+	"canceled": true,
+	"skipped":  true,
+	"manual":   true,
+}
+
+type stageSummary struct {
+	Name     string         `json:"name"`
+	Status   string         `json:"status"`
+	Jobs     map[string]int `json:"jobs"`
+	firstJob int
+}
+
+// summarizeStages returns the statuses of the stages in the pipeline order.
+func summarizeStages(jobs []pipelineJob) (stages []*stageSummary, finished int) {
+	byName := map[string]*stageSummary{}
+	stages = []*stageSummary{}
+	for _, job := range jobs {
+		stage, ok := byName[job.Stage]
+		if !ok {
+			stage = &stageSummary{Name: job.Stage, Jobs: map[string]int{}, firstJob: job.Id}
+			byName[job.Stage] = stage
+			stages = append(stages, stage)
+		}
+
+		stage.Jobs[job.Status]++
+		stage.firstJob = min(stage.firstJob, job.Id)
+		if finishedStatuses[job.Status] {
+			finished++
+		}
+	}
+
+	slices.SortFunc(stages, func(a, b *stageSummary) int { return a.firstJob - b.firstJob })
+
+	for _, stage := range stages {
+		switch {
+		case stage.Jobs["running"] > 0:
+			stage.Status = "running"
+		case stage.Jobs["failed"] > 0:
+			stage.Status = "failed"
+		case stage.Jobs["pending"] > 0 || stage.Jobs["created"] > 0 || stage.Jobs["preparing"] > 0 || stage.Jobs["waiting_for_resource"] > 0:
+			stage.Status = "pending"
+		case stage.Jobs["canceled"] > 0:
+			stage.Status = "canceled"
+		case stage.Jobs["manual"] > 0:
+			stage.Status = "manual"
+		case stage.Jobs["success"] > 0:
+			stage.Status = "success"
+		default:
+			stage.Status = "skipped"
+		}
+	}
+
+	return stages, finished
+}
+
+func stagesMessage(status string, stages []*stageSummary) string {
+	parts := []string{}
+	for _, stage := range stages {
+		parts = append(parts, fmt.Sprintf("%s=%s", stage.Name, stage.Status))
+	}
+
+	return fmt.Sprintf("pipeline %s: %s", status, strings.Join(parts, ", "))
+}
+
+// pollInterval returns the first interval and the timeout of polling.
+func pollInterval(interval int, timeout int) (first time.Duration, limit time.Duration) {
+	if interval <= 0 {
+		interval = 5
+	}
+
+	if timeout <= 0 {
+		timeout = 900
+	}
+
+	return time.Duration(interval) * time.Second, time.Duration(timeout) * time.Second
+}
+
+// poll calls fn with backoff until fn returns true, the timeout expires or the context is canceled.
+func poll(ctx context.Context, interval time.Duration, timeout time.Duration, fn func() (bool, error)) (timedOut bool, err error) {
+	const maxInterval = 60 * time.Second
+
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := fn()
+		if err != nil || done {
+			return false, err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true, nil
+		}
+
+		timer := time.NewTimer(min(interval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(interval*3/2, maxInterval)
+	}
+}
+
+type WaitForPipelineRequest struct {
+	Id         string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	PipelineId int    `json:"pipeline_id" jsonschema:"description=The ID of the pipeline."`
+	Interval   int    `json:"interval,omitempty" jsonschema:"description=Initial polling interval in seconds. The interval increases up to 60 seconds. Default is 5.,minimum=1"`
+	Timeout    int    `json:"timeout,omitempty" jsonschema:"description=Timeout in seconds. Default is 900.,minimum=1,maximum=3600"`
+}
+
+func registerWaitForPipeline(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&WaitForPipelineRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("wait_for_pipeline",
+		mcp.WithDescription("Wait until a pipeline finishes (success, failed, canceled, skipped or manual) or the timeout expires. Progress notifications report the status of each stage. Returns the final status of the pipeline and its stages."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(waitForPipelineHandler)))
+}
+
+func waitForPipelineHandler(ctx context.Context, request mcp.CallToolRequest, req WaitForPipelineRequest) (*mcp.CallToolResult, error) {
+	interval, timeout := pollInterval(req.Interval, req.Timeout)
+	started := time.Now()
+	pipelinePath := fmt.Sprintf("/projects/%s/pipelines/%s", pathEscape(req.Id), pathEscape(req.PipelineId))
+
+	var pipeline pipelineInfo
+	var stages []*stageSummary
+	timedOut, err := poll(ctx, interval, timeout, func() (bool, error) {
+		if err := requestJSON(ctx, http.MethodGet, pipelinePath, nil, nil, &pipeline); err != nil {
+			return false, err
+		}
+
+		jobs, err := requestPages[pipelineJob](ctx, pipelinePath+"/jobs", nil, 1000)
+		if err != nil {
+			return false, err
+		}
+
+		var finished int
+		stages, finished = summarizeStages(jobs)
+		notifyProgress(ctx, request, float64(finished), float64(len(jobs)), stagesMessage(pipeline.Status, stages))
+		return finishedStatuses[pipeline.Status], nil
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return toJSONResult(map[string]any{
+		"pipeline":  pipeline,
+		"stages":    stages,
+		"timed_out": timedOut,
+		"elapsed":   int(time.Since(started).Seconds()),
+	})
+}
+
+type WaitForJobRequest struct {
+	Id       string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	JobId    int    `json:"job_id" jsonschema:"description=The ID of a job."`
+	Interval int    `json:"interval,omitempty" jsonschema:"description=Initial polling interval in seconds. The interval increases up to 60 seconds. Default is 5.,minimum=1"`
+	Timeout  int    `json:"timeout,omitempty" jsonschema:"description=Timeout in seconds. Default is 900.,minimum=1,maximum=3600"`
+}
+
+func registerWaitForJob(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&WaitForJobRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("wait_for_job",
+		mcp.WithDescription("Wait until a job finishes (success, failed, canceled, skipped or manual) or the timeout expires. Progress notifications report the status of the job. Returns the final status of the job."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(waitForJobHandler)))
+}
+
+func waitForJobHandler(ctx context.Context, request mcp.CallToolRequest, req WaitForJobRequest) (*mcp.CallToolResult, error) {
+	interval, timeout := pollInterval(req.Interval, req.Timeout)
+	started := time.Now()
+	jobPath := fmt.Sprintf("/projects/%s/jobs/%s", pathEscape(req.Id), pathEscape(req.JobId))
+
+	var job pipelineJob
+	polls := 0
+	timedOut, err := poll(ctx, interval, timeout, func() (bool, error) {
+		if err := requestJSON(ctx, http.MethodGet, jobPath, nil, nil, &job); err != nil {
+			return false, err
+		}
+
+		polls++
+		notifyProgress(ctx, request, float64(polls), 0, fmt.Sprintf("job %s (%s): %s", job.Name, job.Stage, job.Status))
+		return finishedStatuses[job.Status], nil
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return toJSONResult(map[string]any{
+		"job":       job,
+		"timed_out": timedOut,
+		"elapsed":   int(time.Since(started).Seconds()),
+	})
+}
@@ -0,0 +1,632 @@
+package gitlab
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// vendoredDirs are the directory names excluded from the repository listing by default.
+var vendoredDirs = []string{
+	"vendor",
+	"node_modules",
+	"third_party",
+	"bower_components",
+	".bundle",
+}
+
+// matchGlob reports whether name matches the shell pattern.
+// '**' matches zero or more directories. A pattern without '/' matches the base name at any depth.
+func matchGlob(pattern string, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(patterns []string, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if matchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(patterns[0], names[0]); !ok {
+			return false
+		}
+
+		patterns = patterns[1:]
+		names = names[1:]
+	}
+
+	return len(names) == 0
+}
+
+func matchAnyGlob(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isVendored(name string) bool {
+	dirs := strings.Split(path.Dir(name), "/")
+	for _, dir := range dirs {
+		for _, vendored := range vendoredDirs {
+			if dir == vendored {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type treeEntry struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+// walkTree calls fn for each entry of the repository tree until fn returns false.
+func walkTree(ctx context.Context, id string, ref string, dir string, fn func(treeEntry) bool) error {
+	params := map[string]any{
+		"recursive": true,
+		"per_page":  100,
+		"page":      1,
+	}
+
+	if ref != "" {
+		params["ref"] = ref
+	}
+
+	if dir != "" {
+		params["path"] = dir
+	}
+
+	treePath := fmt.Sprintf("/projects/%s/repository/tree", pathEscape(id))
+	for {
+		response, err := doRequest(ctx, http.MethodGet, treePath, params, nil)
+		if err != nil {
+			return err
+		}
+
+		nextPage := response.Header.Get("X-Next-Page")
+
+		content, err := readResponse(response)
+		if err != nil {
+			return err
+		}
+
+		var entries []treeEntry
+		if err := json.Unmarshal(content, &entries); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if !fn(entry) {
+				return nil
+			}
+		}
+
+		if nextPage == "" || len(entries) == 0 {
+			return nil
+		}
+
+		params["page"] = nextPage
+	}
+}
+
+// defaultBranch returns the default branch of the project.
+func defaultBranch(ctx context.Context, id string) (string, error) {
+	var project struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+
+	projectPath := fmt.Sprintf("/projects/%s", pathEscape(id))
+	if err := requestJSON(ctx, http.MethodGet, projectPath, nil, nil, &project); err != nil {
+		return "", err
+	}
+
+	if project.DefaultBranch == "" {
+		return "", fmt.Errorf("project %s has no default branch", id)
+	}
+
+	return project.DefaultBranch, nil
+}
+
+// fileSize returns the size of the file by reading the 'X-Gitlab-Size' header.
+func fileSize(ctx context.Context, id string, ref string, name string) (int64, error) {
+	filePath := fmt.Sprintf("/projects/%s/repository/files/%s", pathEscape(id), pathEscape(name))
+	response, err := doRequest(ctx, http.MethodHead, filePath, map[string]any{"ref": ref}, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := readResponse(response); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(response.Header.Get("X-Gitlab-Size"), 10, 64)
+}
+
+type repositoryFile struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Size *int64 `json:"size,omitempty"`
+}
+
+// maxSizedFiles is the maximum number of files whose sizes are requested one by one.
+const maxSizedFiles = 1000
+
+type ListRepositoryFilesRequest struct {
+	Id              string   `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Ref             string   `json:"ref,omitempty" jsonschema:"description=The name of a repository branch, tag or commit. Default is the default branch."`
+	Path            string   `json:"path,omitempty" jsonschema:"description=The path inside the repository to list. Default is the root directory."`
+	Include         []string `json:"include,omitempty" jsonschema:"description=Glob patterns of the files to list (e.g. '**/*.go'). '**' matches any number of directories and a pattern without '/' matches the file name at any depth."`
+	Exclude         []string `json:"exclude,omitempty" jsonschema:"description=Glob patterns of the files not to list."`
+	IncludeVendored bool     `json:"include_vendored,omitempty" jsonschema:"description=List files in vendored directories such as vendor and node_modules."`
+	WithSize        bool     `json:"with_size,omitempty" jsonschema:"description=Get the size of each file. This sends one more request per file and fails if more than 1000 files are listed."`
+	Limit           int      `json:"limit,omitempty" jsonschema:"description=Maximum number of files to list. Default is 500.,minimum=1,maximum=10000"`
+}
+
+func registerListRepositoryFiles(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&ListRepositoryFilesRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("list_repository_files",
+		mcp.WithDescription("List files in a repository recursively. Files are filtered by glob patterns and vendored directories are excluded by default."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(listRepositoryFilesHandler)))
+}
+
+func listRepositoryFilesHandler(ctx context.Context, request mcp.CallToolRequest, req ListRepositoryFilesRequest) (*mcp.CallToolResult, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 500
+	}
+
+	files := []repositoryFile{}
+	truncated := false
+	err := walkTree(ctx, req.Id, req.Ref, strings.Trim(req.Path, "/"), func(entry treeEntry) bool {
+		if entry.Type != "blob" {
+			return true
+		}
+
+		if !req.IncludeVendored && isVendored(entry.Path) {
+			return true
+		}
+
+		if len(req.Include) > 0 && !matchAnyGlob(req.Include, entry.Path) {
+			return true
+		}
+
+		if matchAnyGlob(req.Exclude, entry.Path) {
+			return true
+		}
+
+		if len(files) == limit {
+			truncated = true
+			return false
+		}
+
+		files = append(files, repositoryFile{Path: entry.Path, Mode: entry.Mode})
+		return true
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if req.WithSize && len(files) > maxSizedFiles {
+		return mcp.NewToolResultError(fmt.Sprintf("with_size supports at most %d files but %d files are listed: narrow them by path, include or limit", maxSizedFiles, len(files))), nil
+	}
+
+	if req.WithSize && len(files) > 0 {
+		if err := setFileSizes(ctx, req.Id, req.Ref, files); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	return toJSONResult(map[string]any{
+		"files":     files,
+		"truncated": truncated,
+	})
+}
+
+func setFileSizes(ctx context.Context, id string, ref string, files []repositoryFile) error {
+	if ref == "" {
+		branch, err := defaultBranch(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		ref = branch
+	}
+
+	var mu sync.Mutex
+	var firstErr error
+	forEachConcurrently(len(files), func(i int) {
+		size, err := fileSize(ctx, id, ref, files[i].Path)
+		if err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+			return
+		}
+
+		files[i].Size = &size
+	})
+
+	return firstErr
+}
+
+func isGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// binaryHeadSize is the size of the head of the content to detect a binary file.
+const binaryHeadSize = 8000
+
+// isBinary reports whether the content looks like a binary file.
+func isBinary(content []byte) bool {
+	head := content
+	if len(head) > binaryHeadSize {
+		head = head[:binaryHeadSize]
+	}
+
+	return bytes.IndexByte(head, 0) >= 0 || !utf8.Valid(trimIncompleteRune(head))
+}
+
+// trimIncompleteRune removes the multibyte character cut at the end of the content.
+func trimIncompleteRune(content []byte) []byte {
+	for i := len(content) - 1; i >= 0 && len(content)-i < utf8.UTFMax; i-- {
+		if utf8.RuneStart(content[i]) {
+			if !utf8.FullRune(content[i:]) {
+				return content[:i]
+			}
+
+			break
+		}
+	}
+
+	return content
+}
+
+// readLine reads a line including the newline and keeps at most limit bytes of it.
+// The size is the length of the whole line. It returns io.EOF if there are no more lines.
+func readLine(reader *bufio.Reader, limit int) (line []byte, size int, err error) {
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		size += len(chunk)
+		if len(line) < limit {
+			line = append(line, chunk[:min(len(chunk), limit-len(line))]...)
+		}
+
+		switch {
+		case errors.Is(err, bufio.ErrBufferFull):
+			continue
+		case errors.Is(err, io.EOF) && size > 0:
+			return line, size, nil
+		default:
+			return line, size, err
+		}
+	}
+}
+
+// selectedLines are the lines selected from the content.
+type selectedLines struct {
+	Text      string
+	Start     int
+	End       int
+	Truncated bool
+}
+
+// readLines reads the lines from start to end (1-based, inclusive) and cuts the text to at most
+// limit bytes at a line boundary. The rest of the content is not read once the text is cut.
+// The line numbers are prefixed when numbered is true.
+func readLines(reader *bufio.Reader, start int, end int, numbered bool, limit int) (selectedLines, error) {
+	if start <= 0 {
+		start = 1
+	}
+
+	selected := selectedLines{Start: start}
+	text := []byte{}
+	for number := 1; end <= 0 || number <= end; number++ {
+		prefix := ""
+		if numbered {
+			prefix = strconv.Itoa(number) + "\t"
+		}
+
+		keep := 0
+		if start <= number {
+			// One more byte is kept to know whether the line is cut.
+			keep = max(limit-len(text)-len(prefix)+1, 0)
+		}
+
+		line, size, err := readLine(reader, keep)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return selectedLines{}, err
+		}
+
+		if number < start {
+			selected.End = number
+			continue
+		}
+
+		if limit < len(text)+len(prefix)+size {
+			selected.Truncated = true
+			if len(text) == 0 {
+				// The first line longer than the limit is cut.
+				cut := append([]byte(prefix), line...)
+				text = trimIncompleteRune(cut[:min(len(cut), limit)])
+				selected.End = number
+			}
+
+			break
+		}
+
+		text = append(text, prefix...)
+		text = append(text, line...)
+		selected.End = number
+	}
+
+	selected.Text = string(text)
+	return selected, nil
+}
+
+type ReadRepositoryFilesItem struct {
+	Path      string `json:"path" jsonschema:"description=The path of the file in the repository or a glob pattern (e.g. 'cmd/**/*.go')."`
+	StartLine int    `json:"start_line,omitempty" jsonschema:"description=The first line to read (1-based). Default is the first line.,minimum=1"`
+	EndLine   int    `json:"end_line,omitempty" jsonschema:"description=The last line to read (inclusive). Default is the last line.,minimum=1"`
+}
+
+type ReadRepositoryFilesRequest struct {
+	Id            string                    `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	Ref           string                    `json:"ref,omitempty" jsonschema:"description=The name of a repository branch, tag or commit. Default is the default branch."`
+	Files         []ReadRepositoryFilesItem `json:"files" jsonschema:"description=The files to read.,minItems=1"`
+	LineNumbers   bool                      `json:"line_numbers,omitempty" jsonschema:"description=Prefix each line with its line number."`
+	MaxFileBytes  int                       `json:"max_file_bytes,omitempty" jsonschema:"description=Maximum bytes of content per file. Default is 100000.,minimum=1"`
+	MaxTotalBytes int                       `json:"max_total_bytes,omitempty" jsonschema:"description=Maximum bytes of content in total. Default is 500000.,minimum=1"`
+	MaxFiles      int                       `json:"max_files,omitempty" jsonschema:"description=Maximum number of files to read. Default is 100.,minimum=1"`
+}
+
+type readRepositoryFile struct {
+	Path      string `json:"path"`
+	BlobId    string `json:"blob_id,omitempty"`
+	Size      int64  `json:"size,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	EndLine   int    `json:"end_line,omitempty"`
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Skipped   string `json:"skipped,omitempty"`
+}
+
+func registerReadRepositoryFiles(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&ReadRepositoryFilesRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("read_repository_files",
+		mcp.WithDescription("Read multiple files in a repository at once. Binary files are skipped and the content is limited per file and in total."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(readRepositoryFilesHandler)))
+}
+
+func readRepositoryFilesHandler(ctx context.Context, request mcp.CallToolRequest, req ReadRepositoryFilesRequest) (*mcp.CallToolResult, error) {
+	maxFileBytes := req.MaxFileBytes
+	if maxFileBytes <= 0 {
+		maxFileBytes = 100000
+	}
+
+	maxTotalBytes := req.MaxTotalBytes
+	if maxTotalBytes <= 0 {
+		maxTotalBytes = 500000
+	}
+
+	maxFiles := req.MaxFiles
+	if maxFiles <= 0 {
+		maxFiles = 100
+	}
+
+	ref := req.Ref
+	if ref == "" {
+		branch, err := defaultBranch(ctx, req.Id)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		ref = branch
+	}
+
+	items, truncated, err := expandRepositoryFiles(ctx, req.Id, ref, req.Files, maxFiles)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	files := make([]readRepositoryFile, len(items))
+	forEachConcurrently(len(items), func(i int) {
+		files[i] = readFile(ctx, req.Id, ref, items[i], req.LineNumbers, maxFileBytes)
+	})
+
+	// Apply the total limit in the requested order so that the result is deterministic.
+	total := 0
+	for i := range files {
+		if files[i].Content == "" {
+			continue
+		}
+
+		if maxTotalBytes < total+len(files[i].Content) {
+			files[i].Content = ""
+			files[i].Skipped = "total limit exceeded"
+			continue
+		}
+
+		total += len(files[i].Content)
+	}
+
+	return toJSONResult(map[string]any{
+		"ref":       ref,
+		"files":     files,
+		"truncated": truncated,
+	})
+}
+
+// expandRepositoryFiles expands the glob patterns of the items into file paths.
+func expandRepositoryFiles(ctx context.Context, id string, ref string, items []ReadRepositoryFilesItem, maxFiles int) ([]ReadRepositoryFilesItem, bool, error) {
+	expanded := []ReadRepositoryFilesItem{}
+	seen := map[string]bool{}
+	truncated := false
+	add := func(item ReadRepositoryFilesItem) bool {
+		if seen[item.Path] {
+			return true
+		}
+
+		if len(expanded) == maxFiles {
+			truncated = true
+			return false
+		}
+
+		seen[item.Path] = true
+		expanded = append(expanded, item)
+		return true
+	}
+
+	var patterns []ReadRepositoryFilesItem
+	for _, item := range items {
+		item.Path = strings.Trim(item.Path, "/")
+		if isGlob(item.Path) {
+			patterns = append(patterns, item)
+		} else if !add(item) {
+			return expanded, truncated, nil
+		}
+	}
+
+	if len(patterns) == 0 {
+		return expanded, truncated, nil
+	}
+
+	err := walkTree(ctx, id, ref, "", func(entry treeEntry) bool {
+		if entry.Type != "blob" {
+			return true
+		}
+
+		for _, pattern := range patterns {
+			if matchGlob(pattern.Path, entry.Path) {
+				item := pattern
+				item.Path = entry.Path
+				return add(item)
+			}
+		}
+
+		return true
+	})
+
+	return expanded, truncated, err
+}
+
+// readFile reads the file through the raw endpoint as a stream, so that the content of a large file
+// is read only up to the selected lines and the limit.
+func readFile(ctx context.Context, id string, ref string, item ReadRepositoryFilesItem, numbered bool, maxBytes int) readRepositoryFile {
+	result := readRepositoryFile{Path: item.Path}
+
+	rawPath := fmt.Sprintf("/projects/%s/repository/files/%s/raw", pathEscape(id), pathEscape(item.Path))
+	response, err := doRequest(ctx, http.MethodGet, rawPath, map[string]any{"ref": ref}, nil)
+	if err != nil {
+		result.Skipped = err.Error()
+		return result
+	}
+
+	if response.StatusCode < http.StatusOK || http.StatusMultipleChoices <= response.StatusCode {
+		_, err := readResponse(response)
+		result.Skipped = err.Error()
+		return result
+	}
+
+	defer response.Body.Close()
+
+	result.BlobId = response.Header.Get("X-Gitlab-Blob-Id")
+	result.Size, _ = strconv.ParseInt(response.Header.Get("X-Gitlab-Size"), 10, 64)
+
+	reader := bufio.NewReaderSize(response.Body, binaryHeadSize)
+	head, err := reader.Peek(binaryHeadSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		result.Skipped = err.Error()
+		return result
+	}
+
+	if isBinary(head) {
+		result.Skipped = "binary file"
+		return result
+	}
+
+	selected, err := readLines(reader, item.StartLine, item.EndLine, numbered, maxBytes)
+	if err != nil {
+		result.Skipped = err.Error()
+		return result
+	}
+
+	result.StartLine = selected.Start
+	result.EndLine = selected.End
+	result.Content = selected.Text
+	result.Truncated = selected.Truncated
+	return result
+}
@@ -0,0 +1,84 @@
+package gitlab_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.go", name: "main.go", want: true},
+		{pattern: "*.go", name: "cmd/server/main.go", want: true},
+		{pattern: "*.go", name: "main.go.orig", want: false},
+		{pattern: "cmd/*.go", name: "cmd/main.go", want: true},
+		{pattern: "cmd/*.go", name: "cmd/server/main.go", want: false},
+		{pattern: "**/*.go", name: "main.go", want: true},
+		{pattern: "**/*.go", name: "cmd/server/main.go", want: true},
+		{pattern: "cmd/**", name: "cmd/server/main.go", want: true},
+		{pattern: "cmd/**", name: "pkg/main.go", want: false},
+		{pattern: "cmd/**/main.go", name: "cmd/main.go", want: true},
+		{pattern: "cmd/**/main.go", name: "cmd/a/b/main.go", want: true},
+		{pattern: "cmd/**/main.go", name: "cmd/a/b/lib.go", want: false},
+		{pattern: "docs/?.md", name: "docs/a.md", want: true},
+		{pattern: "docs/[ab].md", name: "docs/c.md", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := gitlab.MatchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsVendored(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "vendor/github.com/a/b/c.go", want: true},
+		{name: "web/node_modules/react/index.js", want: true},
+		{name: "third_party/lib.c", want: true},
+		{name: ".bundle/config", want: true},
+		{name: "vendor.go", want: false},
+		{name: "pkg/vendoring/lib.go", want: false},
+		{name: "main.go", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := gitlab.IsVendored(tt.name); got != tt.want {
+			t.Errorf("IsVendored(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestListRepositoryFilesWithSizeLimit(t *testing.T) {
+	fake, project := newFakeProject(t)
+	for i := range 1001 {
+		fake.AddFile(project.Id, "main", "file"+strconv.Itoa(i)+".txt", "content\n")
+	}
+	c, ctx := newTestClient(t, fake, true)
+
+	requests := len(fake.Requests())
+	text, isError := callTool(ctx, t, c, "list_repository_files", map[string]any{
+		"id":        "group/project",
+		"with_size": true,
+		"limit":     1001,
+	})
+	if !isError || !strings.Contains(text, "with_size supports at most 1000 files but 1001 files are listed") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	// The sizes are not requested.
+	for _, r := range fake.Requests()[requests:] {
+		if strings.Contains(r.Path, "/repository/files/") {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.Path)
+		}
+	}
+}
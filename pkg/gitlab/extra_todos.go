@@ -0,0 +1,145 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type GetTodosParams struct {
+	Action    *string `json:"action,omitempty" jsonschema:"description=The action to be filtered.,enum=assigned,enum=mentioned,enum=build_failed,enum=marked,enum=approval_required,enum=unmergeable,enum=directly_addressed,enum=merge_train_removed,enum=member_access_requested"`
+	AuthorId  *int    `json:"author_id,omitempty" jsonschema:"description=The ID of an author"`
+	ProjectId *int    `json:"project_id,omitempty" jsonschema:"description=The ID of a project"`
+	GroupId   *int    `json:"group_id,omitempty" jsonschema:"description=The ID of a group"`
+	State     *string `json:"state,omitempty" jsonschema:"description=The state of the to-do item.,enum=pending,enum=done"`
+	Type      *string `json:"type,omitempty" jsonschema:"description=The type of to-do item.,enum=Issue,enum=MergeRequest,enum=Commit,enum=Epic,enum=DesignManagement::Design,enum=AlertManagement::Alert,enum=Project,enum=Namespace,enum=Vulnerability,enum=WikiPage::Meta"`
+	Page      *int32  `json:"page,omitempty" jsonschema:"description=Current page number"`
+	PerPage   *int32  `json:"per_page,omitempty" jsonschema:"description=Number of items per page"`
+}
+
+type GetTodosRequest struct {
+	Params *GetTodosParams `json:"params,omitempty"`
+}
+
+func registerGetTodos(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetTodosRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_todos",
+		mcp.WithDescription("Get a list of to-do items of the current user. When no filter is applied, it returns all pending to-do items."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getTodosHandler)))
+}
+
+func getTodosHandler(ctx context.Context, request mcp.CallToolRequest, req GetTodosRequest) (*mcp.CallToolResult, error) {
+	return toResult(doRequest(ctx, http.MethodGet, "/todos", req.Params, nil))
+}
+
+type PostTodosIdMarkAsDoneRequest struct {
+	Id int `json:"id" jsonschema:"description=The ID of to-do item"`
+}
+
+func registerPostTodosIdMarkAsDone(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PostTodosIdMarkAsDoneRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("post_todos_id_mark_as_done",
+		mcp.WithDescription("Mark a single pending to-do item given by its ID for the current user as done."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(postTodosIdMarkAsDoneHandler)))
+}
+
+func postTodosIdMarkAsDoneHandler(ctx context.Context, request mcp.CallToolRequest, req PostTodosIdMarkAsDoneRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/todos/%s/mark_as_done", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodPost, path, nil, nil))
+}
+
+type PostTodosMarkAsDoneRequest struct {
+}
+
+func registerPostTodosMarkAsDone(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PostTodosMarkAsDoneRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("post_todos_mark_as_done",
+		mcp.WithDescription("Mark all pending to-do items for the current user as done."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(postTodosMarkAsDoneHandler)))
+}
+
+func postTodosMarkAsDoneHandler(ctx context.Context, request mcp.CallToolRequest, req PostTodosMarkAsDoneRequest) (*mcp.CallToolResult, error) {
+	return toResult(doRequest(ctx, http.MethodPost, "/todos/mark_as_done", nil, nil))
+}
+
+type PostProjectsIdMergeRequestsMergeRequestIidTodoRequest struct {
+	Id              string `json:"id" jsonschema:"description=The ID or URL-encoded path of the project."`
+	MergeRequestIid int    `json:"merge_request_iid" jsonschema:"description=The internal ID of a project's merge request."`
+}
+
+func registerPostProjectsIdMergeRequestsMergeRequestIidTodo(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&PostProjectsIdMergeRequestsMergeRequestIidTodoRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("post_pjs_id_mrs_merge_request_iid_todo",
+		mcp.WithDescription("Create a to-do item"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(postProjectsIdMergeRequestsMergeRequestIidTodoHandler)))
+}
+
+func postProjectsIdMergeRequestsMergeRequestIidTodoHandler(ctx context.Context, request mcp.CallToolRequest, req PostProjectsIdMergeRequestsMergeRequestIidTodoRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/projects/%s/merge_requests/%s/todo", pathEscape(req.Id), pathEscape(req.MergeRequestIid))
+	return toResult(doRequest(ctx, http.MethodPost, path, nil, nil))
+}
@@ -0,0 +1,245 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type GetCurrentUserRequest struct {
+}
+
+func registerGetCurrentUser(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetCurrentUserRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_current_user",
+		mcp.WithDescription("Get the user who owns the token."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getCurrentUserHandler)))
+}
+
+func getCurrentUserHandler(ctx context.Context, request mcp.CallToolRequest, req GetCurrentUserRequest) (*mcp.CallToolResult, error) {
+	return toResult(doRequest(ctx, http.MethodGet, "/user", nil, nil))
+}
+
+type GetUsersParams struct {
+	Username           *string `json:"username,omitempty" jsonschema:"description=Get a single user with a specific username."`
+	Search             *string `json:"search,omitempty" jsonschema:"description=Search for users by name, username, or public email."`
+	Active             *bool   `json:"active,omitempty" jsonschema:"description=Filters only active users."`
+	Blocked            *bool   `json:"blocked,omitempty" jsonschema:"description=Filters only blocked users."`
+	External           *bool   `json:"external,omitempty" jsonschema:"description=Filters only external users."`
+	ExcludeInternal    *bool   `json:"exclude_internal,omitempty" jsonschema:"description=Filters only non internal users."`
+	WithoutProjectBots *bool   `json:"without_project_bots,omitempty" jsonschema:"description=Filters user without project bots."`
+	Page               *int32  `json:"page,omitempty" jsonschema:"description=Current page number"`
+	PerPage            *int32  `json:"per_page,omitempty" jsonschema:"description=Number of items per page"`
+}
+
+type GetUsersRequest struct {
+	Params *GetUsersParams `json:"params,omitempty"`
+}
+
+func registerGetUsers(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users",
+		mcp.WithDescription("List users. Use username to get the ID of a user, or search to find users by name, username or public email."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersHandler)))
+}
+
+func getUsersHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersRequest) (*mcp.CallToolResult, error) {
+	return toResult(doRequest(ctx, http.MethodGet, "/users", req.Params, nil))
+}
+
+type GetUsersIdRequest struct {
+	Id int `json:"id" jsonschema:"description=The ID of the user"`
+}
+
+func registerGetUsersId(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersIdRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users_id",
+		mcp.WithDescription("Get a single user"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersIdHandler)))
+}
+
+func getUsersIdHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersIdRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/users/%s", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, nil, nil))
+}
+
+type GetUsersIdStatusRequest struct {
+	Id string `json:"id" jsonschema:"description=The ID or username of the user"`
+}
+
+func registerGetUsersIdStatus(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersIdStatusRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users_id_status",
+		mcp.WithDescription("Get the status of a user"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersIdStatusHandler)))
+}
+
+func getUsersIdStatusHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersIdStatusRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/users/%s/status", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, nil, nil))
+}
+
+type GetUsersIdKeysRequest struct {
+	Id string `json:"id" jsonschema:"description=The ID or username of the user"`
+}
+
+func registerGetUsersIdKeys(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersIdKeysRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users_id_keys",
+		mcp.WithDescription("Get the SSH keys of a user"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersIdKeysHandler)))
+}
+
+func getUsersIdKeysHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersIdKeysRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/users/%s/keys", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, nil, nil))
+}
+
+type GetUsersIdGpgKeysRequest struct {
+	Id int `json:"id" jsonschema:"description=The ID of the user"`
+}
+
+func registerGetUsersIdGpgKeys(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersIdGpgKeysRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users_id_gpg_keys",
+		mcp.WithDescription("Get the GPG keys of a user"),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersIdGpgKeysHandler)))
+}
+
+func getUsersIdGpgKeysHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersIdGpgKeysRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/users/%s/gpg_keys", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, nil, nil))
+}
+
+type GetUsersIdMembershipsParams struct {
+	Type    *string `json:"type,omitempty" jsonschema:"description=Filter memberships by type.,enum=Project,enum=Namespace"`
+	Page    *int32  `json:"page,omitempty" jsonschema:"description=Current page number"`
+	PerPage *int32  `json:"per_page,omitempty" jsonschema:"description=Number of items per page"`
+}
+
+type GetUsersIdMembershipsRequest struct {
+	Id     int                          `json:"id" jsonschema:"description=The ID of the user"`
+	Params *GetUsersIdMembershipsParams `json:"params,omitempty"`
+}
+
+func registerGetUsersIdMemberships(s *server.MCPServer) {
+	r := &jsonschema.Reflector{}
+	r.DoNotReference = true
+	schemaObj := r.Reflect(&GetUsersIdMembershipsRequest{})
+	mcpSchema, err := json.Marshal(schemaObj)
+	if err != nil {
+		return
+	}
+
+	rawSchema := json.RawMessage(mcpSchema)
+
+	tool := mcp.NewTool("get_users_id_memberships",
+		mcp.WithDescription("Get a list of projects and groups that a user is a member of. This endpoint is available for administrators only."),
+		mcp.WithRawInputSchema(rawSchema),
+		func(tool *mcp.Tool) {
+			tool.InputSchema.Type = ""
+		},
+	)
+
+	s.AddTool(tool, validateArguments(rawSchema, mcp.NewTypedToolHandler(getUsersIdMembershipsHandler)))
+}
+
+func getUsersIdMembershipsHandler(ctx context.Context, request mcp.CallToolRequest, req GetUsersIdMembershipsRequest) (*mcp.CallToolResult, error) {
+	path := fmt.Sprintf("/users/%s/memberships", pathEscape(req.Id))
+	return toResult(doRequest(ctx, http.MethodGet, path, req.Params, nil))
+}
@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// FilterTools removes the tools whose names match none of the patterns such as 'get_*'.
+// The patterns are the same as path.Match. All tools are kept if no pattern is given.
+func FilterTools(s *server.MCPServer, patterns []string) error {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid tool pattern '%s': %w", pattern, err)
+		}
+	}
+
+	tools := s.ListTools()
+	removed := []string{}
+	for name := range tools {
+		if !matchTool(name, patterns) {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(removed) == len(tools) {
+		return fmt.Errorf("no tools match %s", strings.Join(patterns, ", "))
+	}
+
+	s.DeleteTools(removed...)
+	return nil
+}
+
+func matchTool(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,4 @@
+package gitlab
+
+//go:generate bash ../../scripts/fetch-openapi.sh ../../.openapi/openapi.yml
+//go:generate go run ../../internal/gen -spec ../../.openapi/openapi.yml -out .
@@ -0,0 +1,828 @@
+package gitlabtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxPerPage is the maximum number of items per page same as GitLab.
+const maxPerPage = 100
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/version", s.getVersion)
+	mux.HandleFunc("GET /api/v4/user", s.getUser)
+	mux.HandleFunc("GET /api/v4/projects", s.getProjects)
+	mux.HandleFunc("GET /api/v4/projects/{id}", s.getProject)
+	mux.HandleFunc("GET /api/v4/projects/{id}/issues", s.getIssues)
+	mux.HandleFunc("POST /api/v4/projects/{id}/issues", s.postIssue)
+	mux.HandleFunc("GET /api/v4/projects/{id}/issues/{issue_iid}", s.getIssue)
+	mux.HandleFunc("GET /api/v4/projects/{id}/boards/{board_id}", s.getBoard)
+	mux.HandleFunc("GET /api/v4/projects/{id}/boards/{board_id}/lists", s.getBoardLists)
+	mux.HandleFunc("GET /api/v4/projects/{id}/merge_requests", s.getMergeRequests)
+	mux.HandleFunc("GET /api/v4/projects/{id}/merge_requests/{merge_request_iid}", s.getMergeRequest)
+	mux.HandleFunc("GET /api/v4/projects/{id}/merge_requests/{merge_request_iid}/diffs", s.getMergeRequestDiffs)
+	mux.HandleFunc("GET /api/v4/projects/{id}/merge_requests/{merge_request_iid}/commits", s.getMergeRequestCommits)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines", s.getPipelines)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines/latest", s.getLatestPipeline)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines/{pipeline_id}", s.getPipeline)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines/{pipeline_id}/jobs", s.getPipelineJobs)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines/{pipeline_id}/bridges", s.getPipelineBridges)
+	mux.HandleFunc("GET /api/v4/projects/{id}/pipelines/{pipeline_id}/test_report", s.getPipelineTestReport)
+	mux.HandleFunc("POST /api/v4/projects/{id}/ci/lint", s.postCiLint)
+	mux.HandleFunc("GET /api/v4/projects/{id}/jobs/{job_id}", s.getJob)
+	mux.HandleFunc("GET /api/v4/projects/{id}/jobs/{job_id}/trace", s.getJobTrace)
+	mux.HandleFunc("GET /api/v4/projects/{id}/jobs/{job_id}/artifacts", s.getJobArtifacts)
+	mux.HandleFunc("POST /api/v4/projects/{id}/repository/branches", s.postBranch)
+	mux.HandleFunc("DELETE /api/v4/projects/{id}/repository/branches/{branch}", s.deleteBranch)
+	mux.HandleFunc("POST /api/v4/projects/{id}/repository/commits", s.postCommit)
+	mux.HandleFunc("GET /api/v4/projects/{id}/repository/tree", s.getTree)
+	mux.HandleFunc("GET /api/v4/projects/{id}/repository/files/{file_path}", s.getFile)
+	mux.HandleFunc("GET /api/v4/projects/{id}/repository/files/{file_path}/raw", s.getRawFile)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "404 Not Found")
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.record(r); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !authorized(r) {
+			writeError(w, http.StatusUnauthorized, "401 Unauthorized")
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// authorized reports whether the request has the token as a bearer token or a private token.
+func authorized(r *http.Request) bool {
+	return r.Header.Get("Authorization") == "Bearer "+Token || r.Header.Get("PRIVATE-TOKEN") == Token
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error in the same format as GitLab.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+// writePage writes the page of the items selected by the 'page' and 'per_page' parameters
+// with the pagination headers.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 20
+	}
+
+	perPage = min(perPage, maxPerPage)
+
+	start := min(len(items), (page-1)*perPage)
+	end := min(len(items), start+perPage)
+	totalPages := max(1, (len(items)+perPage-1)/perPage)
+
+	w.Header().Set("X-Page", strconv.Itoa(page))
+	w.Header().Set("X-Per-Page", strconv.Itoa(perPage))
+	w.Header().Set("X-Total", strconv.Itoa(len(items)))
+	w.Header().Set("X-Total-Pages", strconv.Itoa(totalPages))
+	if page < totalPages {
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+	}
+
+	writeJSON(w, http.StatusOK, append([]T{}, items[start:end]...))
+}
+
+// project returns the project by the ID or the URL-encoded path, or writes the error.
+func (s *Server) project(w http.ResponseWriter, r *http.Request) *Project {
+	id := r.PathValue("id")
+	for _, p := range s.projects {
+		if strconv.Itoa(p.Id) == id || p.PathWithNamespace == id {
+			return p
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Project Not Found")
+	return nil
+}
+
+func (*Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"version": "18.1.0", "revision": "gitlabtest"})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{"id": s.userId, "username": s.username, "name": "GitLab Test"})
+}
+
+func (s *Server) getProjects(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	search := r.URL.Query().Get("search")
+	projects := []*Project{}
+	for _, p := range s.projects {
+		if search == "" || strings.Contains(p.Name, search) || strings.Contains(p.PathWithNamespace, search) {
+			projects = append(projects, p)
+		}
+	}
+
+	writePage(w, r, projects)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p := s.project(w, r); p != nil {
+		writeJSON(w, http.StatusOK, p)
+	}
+}
+
+func (s *Server) getIssues(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	state := query.Get("state")
+	labels := splitList(query.Get("labels"))
+	issues := []*Issue{}
+	for _, i := range s.issues {
+		if i.ProjectId != p.Id || (state != "" && state != "all" && i.State != state) {
+			continue
+		}
+
+		if !containsAll(i.Labels, labels) {
+			continue
+		}
+
+		issues = append(issues, i)
+	}
+
+	writePage(w, r, issues)
+}
+
+// board returns the board of the project, or writes the error.
+func (s *Server) board(w http.ResponseWriter, r *http.Request) *Board {
+	p := s.project(w, r)
+	if p == nil {
+		return nil
+	}
+
+	for _, b := range s.boards {
+		if b.ProjectId == p.Id && strconv.Itoa(b.Id) == r.PathValue("board_id") {
+			return b
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Board Not Found")
+	return nil
+}
+
+func (s *Server) getBoard(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b := s.board(w, r); b != nil {
+		writeJSON(w, http.StatusOK, b)
+	}
+}
+
+func (s *Server) getBoardLists(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b := s.board(w, r); b != nil {
+		writePage(w, r, b.Lists)
+	}
+}
+
+// postIssue creates the issue by the parameters in the query string or the JSON body.
+func (s *Server) postIssue(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	var body struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Labels      string `json:"labels"`
+	}
+
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	if query.Has("title") {
+		body.Title = query.Get("title")
+	}
+
+	if query.Has("description") {
+		body.Description = query.Get("description")
+	}
+
+	if query.Has("labels") {
+		body.Labels = query.Get("labels")
+	}
+
+	if body.Title == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "title is missing"})
+		return
+	}
+
+	issue := s.insertIssue(p.Id, Issue{Title: body.Title, Description: body.Description, Labels: splitList(body.Labels)})
+	writeJSON(w, http.StatusCreated, issue)
+}
+
+func (s *Server) getIssue(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	for _, i := range s.issues {
+		if i.ProjectId == p.Id && strconv.Itoa(i.Iid) == r.PathValue("issue_iid") {
+			writeJSON(w, http.StatusOK, i)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not found")
+}
+
+func (s *Server) getMergeRequests(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	state := r.URL.Query().Get("state")
+	mergeRequests := []*MergeRequest{}
+	for _, mr := range s.mergeRequests {
+		if mr.ProjectId == p.Id && (state == "" || state == "all" || mr.State == state) {
+			mergeRequests = append(mergeRequests, mr)
+		}
+	}
+
+	writePage(w, r, mergeRequests)
+}
+
+func (s *Server) getMergeRequest(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if mr := s.mergeRequest(w, r); mr != nil {
+		writeJSON(w, http.StatusOK, mr)
+	}
+}
+
+func (s *Server) getMergeRequestDiffs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if mr := s.mergeRequest(w, r); mr != nil {
+		writePage(w, r, mr.Diffs)
+	}
+}
+
+func (s *Server) getMergeRequestCommits(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if mr := s.mergeRequest(w, r); mr != nil {
+		writePage(w, r, mr.Commits)
+	}
+}
+
+// mergeRequest returns the merge request by the project and the IID, or writes the error.
+func (s *Server) mergeRequest(w http.ResponseWriter, r *http.Request) *MergeRequest {
+	p := s.project(w, r)
+	if p == nil {
+		return nil
+	}
+
+	for _, mr := range s.mergeRequests {
+		if mr.ProjectId == p.Id && strconv.Itoa(mr.Iid) == r.PathValue("merge_request_iid") {
+			return mr
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not found")
+	return nil
+}
+
+// getPipelines returns the pipelines of the project from the newest one same as GitLab.
+func (s *Server) getPipelines(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	pipelines := []*Pipeline{}
+	for _, pl := range slices.Backward(s.pipelines) {
+		if pl.ProjectId != p.Id {
+			continue
+		}
+
+		if (query.Has("status") && pl.Status != query.Get("status")) || (query.Has("ref") && pl.Ref != query.Get("ref")) {
+			continue
+		}
+
+		pipelines = append(pipelines, pl)
+	}
+
+	writePage(w, r, pipelines)
+}
+
+func (s *Server) getLatestPipeline(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	ref := r.URL.Query().Get("ref")
+	if ref == "" {
+		ref = p.DefaultBranch
+	}
+
+	for _, pl := range slices.Backward(s.pipelines) {
+		if pl.ProjectId == p.Id && pl.Ref == ref {
+			writeJSON(w, http.StatusOK, pl)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not found")
+}
+
+// pipeline returns the pipeline of the project, or writes the error.
+func (s *Server) pipeline(w http.ResponseWriter, r *http.Request) *Pipeline {
+	p := s.project(w, r)
+	if p == nil {
+		return nil
+	}
+
+	for _, pl := range s.pipelines {
+		if pl.ProjectId == p.Id && strconv.Itoa(pl.Id) == r.PathValue("pipeline_id") {
+			return pl
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not found")
+	return nil
+}
+
+func (s *Server) getPipeline(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pl := s.pipeline(w, r); pl != nil {
+		writeJSON(w, http.StatusOK, pl)
+	}
+}
+
+// getPipelineJobs returns the jobs of the pipeline filtered by the 'scope[]' parameters.
+func (s *Server) getPipelineJobs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pl := s.pipeline(w, r)
+	if pl == nil {
+		return
+	}
+
+	scopes := r.URL.Query()["scope[]"]
+	jobs := []*Job{}
+	for _, j := range s.jobs {
+		if j.Pipeline.Id == pl.Id && (len(scopes) == 0 || slices.Contains(scopes, j.Status)) {
+			jobs = append(jobs, j)
+		}
+	}
+
+	writePage(w, r, jobs)
+}
+
+func (s *Server) getPipelineBridges(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pl := s.pipeline(w, r); pl != nil {
+		writePage(w, r, []any{})
+	}
+}
+
+func (s *Server) getPipelineTestReport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pl := s.pipeline(w, r); pl != nil {
+		writeJSON(w, http.StatusOK, map[string]any{"total_count": 0, "test_suites": []any{}})
+	}
+}
+
+// postCiLint reports the content as a valid configuration without jobs.
+func (s *Server) postCiLint(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body struct {
+		Content string `json:"content"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if p := s.project(w, r); p != nil {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"valid":       true,
+			"errors":      []string{},
+			"warnings":    []string{},
+			"merged_yaml": body.Content,
+			"jobs":        []any{},
+		})
+	}
+}
+
+// job returns the job of the project, or writes the error.
+func (s *Server) job(w http.ResponseWriter, r *http.Request) *Job {
+	p := s.project(w, r)
+	if p == nil {
+		return nil
+	}
+
+	for _, j := range s.jobs {
+		if j.Pipeline.ProjectId == p.Id && strconv.Itoa(j.Id) == r.PathValue("job_id") {
+			return j
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "404 Not found")
+	return nil
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if j := s.job(w, r); j != nil {
+		writeJSON(w, http.StatusOK, j)
+	}
+}
+
+func (s *Server) getJobTrace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if j := s.job(w, r); j != nil {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(j.Trace))
+	}
+}
+
+// getJobArtifacts returns the artifacts archive. The 'Range' header is ignored
+// if the range requests are disabled.
+func (s *Server) getJobArtifacts(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j := s.job(w, r)
+	if j == nil {
+		return
+	}
+
+	if j.Artifacts == nil {
+		writeError(w, http.StatusNotFound, "404 Not Found")
+		return
+	}
+
+	if s.noRange {
+		r.Header.Del("Range")
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, "artifacts.zip", time.Time{}, bytes.NewReader(j.Artifacts))
+}
+
+// refFiles returns the files at the ref of the project. The ref is the default branch if empty.
+func (s *Server) refFiles(p *Project, ref string) map[string]string {
+	if ref == "" {
+		ref = p.DefaultBranch
+	}
+
+	return s.files[p.Id][ref]
+}
+
+// postBranch creates the branch with the files of the 'ref' parameter.
+func (s *Server) postBranch(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	branch := query.Get("branch")
+	if s.files[p.Id][branch] != nil {
+		writeError(w, http.StatusBadRequest, "Branch already exists")
+		return
+	}
+
+	files := s.refFiles(p, query.Get("ref"))
+	if files == nil {
+		writeError(w, http.StatusBadRequest, "Invalid reference name: "+query.Get("ref"))
+		return
+	}
+
+	s.files[p.Id][branch] = maps.Clone(files)
+	writeJSON(w, http.StatusCreated, map[string]any{"name": branch})
+}
+
+func (s *Server) deleteBranch(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	branch := r.PathValue("branch")
+	if s.files[p.Id][branch] == nil {
+		writeError(w, http.StatusNotFound, "404 Branch Not Found")
+		return
+	}
+
+	delete(s.files[p.Id], branch)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type commitAction struct {
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	Content  string `json:"content"`
+}
+
+// postCommit commits the 'create', 'update' and 'delete' actions to the branch.
+// No file is changed if one of the actions fails same as GitLab.
+func (s *Server) postCommit(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	var body struct {
+		Branch        string         `json:"branch"`
+		CommitMessage string         `json:"commit_message"`
+		Actions       []commitAction `json:"actions"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if s.files[p.Id][body.Branch] == nil {
+		writeError(w, http.StatusBadRequest, "You can only create or edit files when you are on a branch")
+		return
+	}
+
+	files := maps.Clone(s.files[p.Id][body.Branch])
+	for _, action := range body.Actions {
+		_, exists := files[action.FilePath]
+		switch {
+		case action.Action == "create" && !exists:
+			files[action.FilePath] = action.Content
+		case action.Action == "create":
+			writeError(w, http.StatusBadRequest, "A file with this name already exists")
+			return
+		case action.Action == "update" && exists:
+			files[action.FilePath] = action.Content
+		case action.Action == "delete" && exists:
+			delete(files, action.FilePath)
+		case action.Action == "update" || action.Action == "delete":
+			writeError(w, http.StatusBadRequest, "A file with this name doesn't exist")
+			return
+		default:
+			writeError(w, http.StatusBadRequest, "Unknown action '"+action.Action+"'")
+			return
+		}
+	}
+
+	s.files[p.Id][body.Branch] = files
+	id := "commit-" + strconv.Itoa(s.id())
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"id":       id,
+		"short_id": id,
+		"title":    strings.SplitN(body.CommitMessage, "\n", 2)[0],
+		"message":  body.CommitMessage,
+	})
+}
+
+// getTree returns the files and the directories under the 'path' parameter.
+func (s *Server) getTree(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.project(w, r)
+	if p == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	files := s.refFiles(p, query.Get("ref"))
+	if files == nil {
+		writeError(w, http.StatusNotFound, "404 Tree Not Found")
+		return
+	}
+
+	dir := strings.Trim(query.Get("path"), "/")
+	recursive := query.Get("recursive") == "true"
+
+	type treeEntry struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+		Path string `json:"path"`
+		Mode string `json:"mode"`
+	}
+
+	entries := map[string]treeEntry{}
+	for name := range files {
+		rel := name
+		if dir != "" {
+			var ok bool
+			if rel, ok = strings.CutPrefix(name, dir+"/"); !ok {
+				continue
+			}
+		}
+
+		segments := strings.Split(rel, "/")
+		for i := range segments {
+			if i > 0 && !recursive {
+				break
+			}
+
+			entryPath := path.Join(dir, strings.Join(segments[:i+1], "/"))
+			entry := treeEntry{Name: segments[i], Type: "tree", Path: entryPath, Mode: "040000"}
+			if i == len(segments)-1 {
+				entry.Type = "blob"
+				entry.Mode = "100644"
+			}
+
+			entries[entryPath] = entry
+		}
+	}
+
+	tree := []treeEntry{}
+	for _, entry := range entries {
+		tree = append(tree, entry)
+	}
+
+	slices.SortFunc(tree, func(a, b treeEntry) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	writePage(w, r, tree)
+}
+
+// file returns the path and the content of the file, or writes the error.
+func (s *Server) file(w http.ResponseWriter, r *http.Request, ref string) (name string, content string, ok bool) {
+	p := s.project(w, r)
+	if p == nil {
+		return "", "", false
+	}
+
+	name = r.PathValue("file_path")
+	content, ok = s.refFiles(p, ref)[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "404 File Not Found")
+		return "", "", false
+	}
+
+	return name, content, true
+}
+
+// getFile returns the file with base64 encoded content. The 'ref' parameter is required same as GitLab.
+func (s *Server) getFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ref := r.URL.Query().Get("ref")
+	if ref == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "ref is missing"})
+		return
+	}
+
+	name, content, ok := s.file(w, r, ref)
+	if !ok {
+		return
+	}
+
+	setFileHeaders(w, name, ref, content)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"file_name": path.Base(name),
+		"file_path": name,
+		"size":      len(content),
+		"encoding":  "base64",
+		"content":   base64.StdEncoding.EncodeToString([]byte(content)),
+		"ref":       ref,
+		"blob_id":   blobId(content),
+	})
+}
+
+// setFileHeaders sets the headers of the file same as GitLab, which are also sent by HEAD and the raw file.
+func setFileHeaders(w http.ResponseWriter, name string, ref string, content string) {
+	w.Header().Set("X-Gitlab-Blob-Id", blobId(content))
+	w.Header().Set("X-Gitlab-File-Path", name)
+	w.Header().Set("X-Gitlab-Ref", ref)
+	w.Header().Set("X-Gitlab-Size", strconv.Itoa(len(content)))
+}
+
+func blobId(content string) string {
+	return "blob-" + strconv.Itoa(len(content))
+}
+
+func (s *Server) getRawFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ref := r.URL.Query().Get("ref")
+	if name, content, ok := s.file(w, r, ref); ok {
+		setFileHeaders(w, name, ref, content)
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(content))
+	}
+}
+
+func splitList(value string) []string {
+	items := []string{}
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func containsAll(values []string, items []string) bool {
+	for _, item := range items {
+		if !slices.Contains(values, item) {
+			return false
+		}
+	}
+
+	return true
+}
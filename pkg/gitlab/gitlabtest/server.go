@@ -0,0 +1,472 @@
+// Package gitlabtest provides an in-memory fake of the GitLab REST API v4 for tests.
+//
+// The fake serves a small part of the API (the current user, projects, issues,
+// merge requests, pipelines, jobs, job artifacts, repository branches, files and
+// commits, issue boards and CI lint) through httptest.Server, and records the
+// requests to check the headers, the paths and the query parameters.
+package gitlabtest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// Token is the token accepted by the fake server.
+const Token = "gitlabtest-token"
+
+// Username is the default username of the user authenticated by Token.
+const Username = "gitlabtest"
+
+type Project struct {
+	Id                int    `json:"id"`
+	Name              string `json:"name"`
+	Path              string `json:"path"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+	WebUrl            string `json:"web_url"`
+}
+
+type Issue struct {
+	Id          int      `json:"id"`
+	Iid         int      `json:"iid"`
+	ProjectId   int      `json:"project_id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	State       string   `json:"state"`
+	Labels      []string `json:"labels"`
+}
+
+type MergeRequest struct {
+	Id           int    `json:"id"`
+	Iid          int    `json:"iid"`
+	ProjectId    int    `json:"project_id"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	State        string `json:"state"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	Sha          string `json:"sha"`
+	// Diffs are the changed files served by '/merge_requests/:merge_request_iid/diffs'.
+	Diffs []Diff `json:"-"`
+	// Commits are the commits served by '/merge_requests/:merge_request_iid/commits'.
+	Commits []Commit `json:"-"`
+}
+
+type Commit struct {
+	Id         string `json:"id"`
+	ShortId    string `json:"short_id"`
+	Title      string `json:"title"`
+	AuthorName string `json:"author_name"`
+}
+
+type Diff struct {
+	OldPath     string `json:"old_path"`
+	NewPath     string `json:"new_path"`
+	NewFile     bool   `json:"new_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	DeletedFile bool   `json:"deleted_file"`
+	Diff        string `json:"diff"`
+}
+
+type Pipeline struct {
+	Id        int    `json:"id"`
+	Iid       int    `json:"iid"`
+	ProjectId int    `json:"project_id"`
+	Ref       string `json:"ref"`
+	Sha       string `json:"sha"`
+	Status    string `json:"status"`
+	WebUrl    string `json:"web_url"`
+}
+
+type JobPipeline struct {
+	Id        int    `json:"id"`
+	ProjectId int    `json:"project_id"`
+	Ref       string `json:"ref"`
+	Sha       string `json:"sha"`
+	Status    string `json:"status"`
+}
+
+type Job struct {
+	Id            int         `json:"id"`
+	Name          string      `json:"name"`
+	Stage         string      `json:"stage"`
+	Status        string      `json:"status"`
+	Ref           string      `json:"ref"`
+	AllowFailure  bool        `json:"allow_failure"`
+	FailureReason string      `json:"failure_reason,omitempty"`
+	WebUrl        string      `json:"web_url"`
+	Pipeline      JobPipeline `json:"pipeline"`
+	// Trace is the log of the job served by '/jobs/:job_id/trace'.
+	Trace string `json:"-"`
+	// Artifacts is the artifacts archive of the job served by '/jobs/:job_id/artifacts'.
+	Artifacts []byte `json:"-"`
+}
+
+type Label struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Milestone struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+type User struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type Board struct {
+	Id        int        `json:"id"`
+	ProjectId int        `json:"-"`
+	Name      string     `json:"name"`
+	Milestone *Milestone `json:"milestone"`
+	Assignee  *User      `json:"assignee"`
+	Labels    []Label    `json:"labels"`
+	Weight    *int       `json:"weight"`
+	// Lists are the lists served by '/boards/:board_id/lists'.
+	Lists []BoardList `json:"-"`
+}
+
+type BoardList struct {
+	Id        int        `json:"id"`
+	ListType  string     `json:"list_type"`
+	Position  int        `json:"position"`
+	Label     *Label     `json:"label"`
+	Assignee  *User      `json:"assignee"`
+	Milestone *Milestone `json:"milestone"`
+}
+
+// Request is a request received by the fake server.
+type Request struct {
+	Method string
+	// Path is the escaped path such as '/api/v4/projects/group%2Fproject'.
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake GitLab server.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	nextId        int
+	projects      []*Project
+	issues        []*Issue
+	mergeRequests []*MergeRequest
+	pipelines     []*Pipeline
+	jobs          []*Job
+	boards        []*Board
+	// files are the contents of the files by project ID, ref and path.
+	files    map[int]map[string]map[string]string
+	requests []Request
+	// noRange is true if the range requests are not supported.
+	noRange bool
+	// userId and username are of the user authenticated by Token.
+	userId   int
+	username string
+}
+
+// NewServer starts a fake GitLab server which is closed at the end of the test.
+func NewServer(tb testing.TB) *Server {
+	s := &Server{
+		nextId:   1,
+		files:    map[int]map[string]map[string]string{},
+		userId:   1,
+		username: Username,
+	}
+
+	s.Server = httptest.NewServer(s.handler())
+	tb.Cleanup(s.Close)
+	return s
+}
+
+// Requests returns the requests received by the server in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// LastRequest returns the last request received by the server.
+func (s *Server) LastRequest() *Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return nil
+	}
+
+	r := s.requests[len(s.requests)-1]
+	return &r
+}
+
+// AddProject adds the project. The ID, the path and the URL are filled if empty.
+func (s *Server) AddProject(p Project) *Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.Id == 0 {
+		p.Id = s.id()
+	}
+
+	if p.Path == "" {
+		p.Path = p.Name
+	}
+
+	if p.PathWithNamespace == "" {
+		p.PathWithNamespace = p.Path
+	}
+
+	if p.DefaultBranch == "" {
+		p.DefaultBranch = "main"
+	}
+
+	if p.WebUrl == "" {
+		p.WebUrl = s.URL + "/" + p.PathWithNamespace
+	}
+
+	s.projects = append(s.projects, &p)
+	return &p
+}
+
+// AddIssue adds the issue to the project. The IDs are filled if zero.
+func (s *Server) AddIssue(projectId int, i Issue) *Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insertIssue(projectId, i)
+}
+
+// insertIssue adds the issue while the lock is held.
+func (s *Server) insertIssue(projectId int, i Issue) *Issue {
+	i.ProjectId = projectId
+	if i.Id == 0 {
+		i.Id = s.id()
+	}
+
+	if i.Iid == 0 {
+		for _, other := range s.issues {
+			if other.ProjectId == projectId {
+				i.Iid = max(i.Iid, other.Iid)
+			}
+		}
+
+		i.Iid++
+	}
+
+	if i.State == "" {
+		i.State = "opened"
+	}
+
+	if i.Labels == nil {
+		i.Labels = []string{}
+	}
+
+	s.issues = append(s.issues, &i)
+	return &i
+}
+
+// AddMergeRequest adds the merge request to the project. The IDs are filled if zero.
+func (s *Server) AddMergeRequest(projectId int, mr MergeRequest) *MergeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mr.ProjectId = projectId
+	if mr.Id == 0 {
+		mr.Id = s.id()
+	}
+
+	if mr.Iid == 0 {
+		for _, other := range s.mergeRequests {
+			if other.ProjectId == projectId {
+				mr.Iid = max(mr.Iid, other.Iid)
+			}
+		}
+
+		mr.Iid++
+	}
+
+	if mr.State == "" {
+		mr.State = "opened"
+	}
+
+	s.mergeRequests = append(s.mergeRequests, &mr)
+	return &mr
+}
+
+// AddPipeline adds the pipeline to the project. The IDs are filled if zero.
+func (s *Server) AddPipeline(projectId int, p Pipeline) *Pipeline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p.ProjectId = projectId
+	if p.Id == 0 {
+		p.Id = s.id()
+	}
+
+	if p.Iid == 0 {
+		p.Iid = p.Id
+	}
+
+	if p.Status == "" {
+		p.Status = "success"
+	}
+
+	if p.WebUrl == "" {
+		p.WebUrl = s.URL + "/pipelines/" + strconv.Itoa(p.Id)
+	}
+
+	s.pipelines = append(s.pipelines, &p)
+	return &p
+}
+
+// AddJob adds the job to the pipeline. The ID is filled if zero.
+func (s *Server) AddJob(pipelineId int, j Job) *Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.pipelines {
+		if p.Id == pipelineId {
+			j.Pipeline = JobPipeline{Id: p.Id, ProjectId: p.ProjectId, Ref: p.Ref, Sha: p.Sha, Status: p.Status}
+			j.Ref = p.Ref
+		}
+	}
+
+	if j.Id == 0 {
+		j.Id = s.id()
+	}
+
+	if j.Status == "" {
+		j.Status = "success"
+	}
+
+	if j.WebUrl == "" {
+		j.WebUrl = s.URL + "/jobs/" + strconv.Itoa(j.Id)
+	}
+
+	s.jobs = append(s.jobs, &j)
+	return &j
+}
+
+// AddBoard adds the board to the project. The IDs of the board and the lists are filled if zero.
+func (s *Server) AddBoard(projectId int, b Board) *Board {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b.ProjectId = projectId
+	if b.Id == 0 {
+		b.Id = s.id()
+	}
+
+	if b.Labels == nil {
+		b.Labels = []Label{}
+	}
+
+	b.Lists = append([]BoardList{}, b.Lists...)
+	for i := range b.Lists {
+		if b.Lists[i].Id == 0 {
+			b.Lists[i].Id = s.id()
+		}
+	}
+
+	s.boards = append(s.boards, &b)
+	return &b
+}
+
+// SetPipelineStatus changes the status of the pipeline.
+func (s *Server) SetPipelineStatus(pipelineId int, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.pipelines {
+		if p.Id == pipelineId {
+			p.Status = status
+		}
+	}
+}
+
+// SetJobStatus changes the status of the job.
+func (s *Server) SetJobStatus(jobId int, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range s.jobs {
+		if j.Id == jobId {
+			j.Status = status
+		}
+	}
+}
+
+// SetUser changes the user authenticated by Token.
+func (s *Server) SetUser(id int, username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.userId = id
+	s.username = username
+}
+
+// SetRangeRequests enables or disables the range requests of the artifacts archive.
+// The range requests are enabled by default.
+func (s *Server) SetRangeRequests(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.noRange = !enabled
+}
+
+// AddFile adds the file at the ref of the project.
+func (s *Server) AddFile(projectId int, ref string, path string, content string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.files[projectId] == nil {
+		s.files[projectId] = map[string]map[string]string{}
+	}
+
+	if s.files[projectId][ref] == nil {
+		s.files[projectId][ref] = map[string]string{}
+	}
+
+	s.files[projectId][ref][path] = content
+}
+
+func (s *Server) id() int {
+	id := s.nextId
+	s.nextId++
+	return id
+}
+
+// record records the request and restores the body to be read by the handler.
+func (s *Server) record(r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.EscapedPath(),
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	return nil
+}
@@ -1,10 +1,14 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	client "github.com/9506hqwy/gitlab-client-go/pkg/gitlab"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -12,6 +16,11 @@ import (
 
 type UrlKey struct{}
 type TokenKey struct{}
+type UploadDirKey struct{}
+
+// TransportKey is the context key of the http.RoundTripper used by the GitLab client.
+// The default transport is used if it is not set.
+type TransportKey struct{}
 
 func authorizationHeader(ctx context.Context, req *http.Request) error {
 	return bearerAuth(ctx, req)
@@ -27,15 +36,208 @@ func bearerAuth(ctx context.Context, req *http.Request) error {
 	return nil
 }
 
+func serverUrl(ctx context.Context) (string, error) {
+	base, ok := ctx.Value(UrlKey{}).(string)
+	if !ok || base == "" {
+		return "", fmt.Errorf("missing url")
+	}
+
+	return base, nil
+}
+
+func newHTTPClient(ctx context.Context) *http.Client {
+	transport := http.DefaultTransport
+	if t, ok := ctx.Value(TransportKey{}).(http.RoundTripper); ok && t != nil {
+		transport = t
+	}
+
+	if a, ok := ctx.Value(auditKey{}).(*AuditLog); ok {
+		transport = &auditTransport{next: transport, log: a}
+	}
+
+	return &http.Client{Transport: &tracingTransport{next: &metricsTransport{next: &loggingTransport{next: transport}}}}
+}
+
 func newClient(ctx context.Context) (*client.ClientWithResponses, error) {
-	hc := http.Client{}
+	hc := newHTTPClient(ctx)
 
-	url, ok := ctx.Value(UrlKey{}).(string)
-	if !ok || url == "" {
-		return nil, fmt.Errorf("missing url")
+	base, err := serverUrl(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return client.NewClientWithResponses(url, client.WithHTTPClient(&hc))
+	return client.NewClientWithResponses(base, client.WithHTTPClient(hc))
+}
+
+// doRequest calls the GitLab REST API v4 endpoint at path for operations that
+// are not provided by gitlab-client-go. params is encoded as query string and
+// body is encoded as JSON, the same way as the generated request structs.
+func doRequest(ctx context.Context, method string, path string, params any, body any) (*http.Response, error) {
+	req, err := newRequest(ctx, method, path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHTTPClient(ctx).Do(req)
+}
+
+// newRequest creates an authorized request to the GitLab REST API v4 endpoint at path.
+func newRequest(ctx context.Context, method string, path string, params any, body any) (*http.Request, error) {
+	base, err := serverUrl(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(base, "/") + "/api/v4" + path)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := queryValues(params)
+	if err != nil {
+		return nil, err
+	}
+
+	u.RawQuery = query.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if err := authorizationHeader(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func queryValues(params any) (url.Values, error) {
+	query := url.Values{}
+	if params == nil {
+		return query, nil
+	}
+
+	content, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case []any:
+			for _, item := range v {
+				query.Add(key, fmt.Sprint(item))
+			}
+		default:
+			query.Set(key, fmt.Sprint(v))
+		}
+	}
+
+	return query, nil
+}
+
+// requestJSON calls doRequest and decodes the JSON response body into v.
+// It returns an error when the status code is not 2xx.
+func requestJSON(ctx context.Context, method string, path string, params any, body any, v any) error {
+	response, err := doRequest(ctx, method, path, params, body)
+	if err != nil {
+		return err
+	}
+
+	content, err := readResponse(response)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	return json.Unmarshal(content, v)
+}
+
+// requestPages calls the GET endpoint at path page by page following the
+// 'X-Next-Page' header, and returns at most limit items.
+func requestPages[T any](ctx context.Context, path string, params map[string]any, limit int) ([]T, error) {
+	query := map[string]any{"per_page": 100}
+	for key, value := range params {
+		query[key] = value
+	}
+
+	items := []T{}
+	for {
+		response, err := doRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		nextPage := response.Header.Get("X-Next-Page")
+
+		content, err := readResponse(response)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []T
+		if err := json.Unmarshal(content, &page); err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+		if limit <= len(items) {
+			return items[:limit], nil
+		}
+
+		if nextPage == "" || len(page) == 0 {
+			return items, nil
+		}
+
+		query["page"] = nextPage
+	}
+}
+
+// readResponse reads and closes the response body.
+// It returns an error when the status code is not 2xx.
+func readResponse(response *http.Response) ([]byte, error) {
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < http.StatusOK || http.StatusMultipleChoices <= response.StatusCode {
+		return nil, fmt.Errorf("%s: %s", response.Status, string(content))
+	}
+
+	return content, nil
+}
+
+func pathEscape(value any) string {
+	return url.PathEscape(fmt.Sprint(value))
 }
 
 func toResult(response *http.Response, err error) (*mcp.CallToolResult, error) {
@@ -56,3 +258,12 @@ func toResult(response *http.Response, err error) (*mcp.CallToolResult, error) {
 
 	return mcp.NewToolResultText(string(body)), nil
 }
+
+func toJSONResult(v any) (*mcp.CallToolResult, error) {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(string(content)), nil
+}
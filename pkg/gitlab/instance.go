@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// instanceArgument is the name of the argument to select the GitLab instance.
+const instanceArgument = "instance"
+
+// instanceKey is the context key of the name of the GitLab instance selected by the tool call.
+type instanceKey struct{}
+
+// Instance is a GitLab server selected by the 'instance' argument of the tools.
+type Instance struct {
+	Url   string
+	Token string
+	// Readonly rejects the calls of the tools which are not registered in the readonly mode.
+	Readonly bool
+	// Transport is the transport to the instance. The transport in the context is used if nil,
+	// such as to record or replay the traffic to all instances.
+	Transport http.RoundTripper
+}
+
+// RegisterInstances adds the optional 'instance' argument to the registered tools, so that
+// the tool is called against the named instance instead of the server in the context.
+// The URL, the token and the transport are resolved for each call.
+// The readonly instances accept only the tools registered in the readonly mode, such as
+// 'lint_ci_config' which sends a POST request without changing GitLab resources.
+func RegisterInstances(s *server.MCPServer, instances map[string]Instance) error {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	readonlyServer := server.NewMCPServer("readonly", "")
+	RegisterTools(readonlyServer, true)
+	readonlyTools := readonlyServer.ListTools()
+
+	names := slices.Sorted(maps.Keys(instances))
+	tools := []server.ServerTool{}
+	for _, tool := range s.ListTools() {
+		schema, err := addInstanceProperty(tool.Tool.RawInputSchema, names)
+		if err != nil {
+			return fmt.Errorf("%s: %w", tool.Tool.Name, err)
+		}
+
+		tool.Tool.RawInputSchema = schema
+		_, readonly := readonlyTools[tool.Tool.Name]
+		tools = append(tools, server.ServerTool{Tool: tool.Tool, Handler: withInstance(instances, readonly, tool.Handler)})
+	}
+
+	s.AddTools(tools...)
+	return nil
+}
+
+// addInstanceProperty returns the input schema with the 'instance' property.
+func addInstanceProperty(raw json.RawMessage, names []string) (json.RawMessage, error) {
+	var schema map[string]any
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, err
+	}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		schema["properties"] = properties
+	}
+
+	properties[instanceArgument] = map[string]any{
+		"type":        "string",
+		"enum":        names,
+		"description": "Name of the GitLab instance. The default instance is used if not specified.",
+	}
+
+	return json.Marshal(schema)
+}
+
+// withInstance returns the handler which removes the 'instance' argument and calls the handler
+// with the URL, the token and the transport of the instance. The tool is rejected if the instance
+// is readonly and the tool is not readonly.
+func withInstance(instances map[string]Instance, readonly bool, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := request.GetArguments()
+		value, ok := arguments[instanceArgument]
+		if !ok {
+			return handler(ctx, request)
+		}
+
+		name, ok := value.(string)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid instance: %v", value)), nil
+		}
+
+		instance, ok := instances[name]
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown instance: %s", name)), nil
+		}
+
+		if instance.Readonly && !readonly {
+			return mcp.NewToolResultError(fmt.Sprintf("instance %s is readonly", name)), nil
+		}
+
+		rest := maps.Clone(arguments)
+		delete(rest, instanceArgument)
+		request.Params.Arguments = rest
+
+		transport := instance.Transport
+		if transport == nil {
+			if t, ok := ctx.Value(TransportKey{}).(http.RoundTripper); ok && t != nil {
+				transport = t
+			} else {
+				transport = http.DefaultTransport
+			}
+		}
+
+		ctx = context.WithValue(ctx, UrlKey{}, instance.Url)
+		ctx = context.WithValue(ctx, TokenKey{}, instance.Token)
+		ctx = context.WithValue(ctx, TransportKey{}, transport)
+		ctx = context.WithValue(ctx, instanceKey{}, name)
+		return handler(ctx, request)
+	}
+}
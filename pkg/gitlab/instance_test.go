@@ -0,0 +1,90 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+type instanceProperty struct {
+	Enum []string `json:"enum"`
+}
+
+type instanceSchema struct {
+	Properties map[string]instanceProperty `json:"properties"`
+}
+
+func TestInstances(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	other := gitlabtest.NewServer(t)
+	other.AddProject(gitlabtest.Project{Name: "mirror", PathWithNamespace: "group/mirror"})
+	readonly := gitlabtest.NewServer(t)
+	readonly.AddProject(gitlabtest.Project{Name: "project", PathWithNamespace: "group/project"})
+
+	s := server.NewMCPServer("GitLab MCP Server", "0.1.0")
+	gitlab.RegisterTools(s, false)
+	err := gitlab.RegisterInstances(s, map[string]gitlab.Instance{
+		"other":    {Url: other.URL, Token: gitlabtest.Token},
+		"readonly": {Url: readonly.URL, Token: gitlabtest.Token, Readonly: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, ctx := connectTestClient(t, s, fake)
+
+	var project gitlabtest.Project
+	callToolJSON(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/mirror", "instance": "other"}, &project)
+	if project.Name != "mirror" {
+		t.Errorf("project = %+v", project)
+	}
+
+	callToolJSON(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/project"}, &project)
+	if project.Name != "project" || len(other.Requests()) != 1 {
+		t.Errorf("project = %+v", project)
+	}
+
+	text, isError := callTool(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/project", "instance": "missing"})
+	if !isError || !strings.Contains(text, "unknown instance") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	text, isError = callTool(ctx, t, c, "post_pjs_id_issues", map[string]any{"id": "group/project", "instance": "readonly", "params": map[string]any{"title": "new issue"}})
+	if !isError || !strings.Contains(text, "instance readonly is readonly") || len(readonly.Requests()) != 0 {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	// The readonly tool sending a POST request is called against the readonly instance.
+	text, isError = callTool(ctx, t, c, "lint_ci_config", map[string]any{"id": "group/project", "instance": "readonly", "ref": "main", "content": "test:\n  script: go test\n"})
+	if isError || len(readonly.Requests()) != 1 || readonly.LastRequest().Method != http.MethodPost {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	tools, err := c.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tool := range tools.Tools {
+		raw, err := json.Marshal(tool.InputSchema)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var schema instanceSchema
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			t.Fatal(err)
+		}
+
+		if enum := schema.Properties["instance"].Enum; strings.Join(enum, ",") != "other,readonly" {
+			t.Errorf("%s: unexpected instances: %v", tool.Name, enum)
+		}
+	}
+}
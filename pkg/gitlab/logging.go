@@ -0,0 +1,155 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// secretArguments are the argument names whose values are redacted in the logs.
+// The names containing these words such as 'new_password' are redacted too.
+var secretArguments = []string{
+	"content",
+	"password",
+	"private_key",
+	"secret",
+	"token",
+	"value",
+}
+
+// toolNameKey is the context key of the name of the tool being called.
+type toolNameKey struct{}
+
+// LoggingMiddleware logs each tool call with the redacted arguments and the duration to the default logger.
+// The requests to GitLab sent by the tool are logged with the tool name.
+func LoggingMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name := request.Params.Name
+		ctx = context.WithValue(ctx, toolNameKey{}, name)
+
+		start := time.Now()
+		result, err := next(ctx, request)
+
+		attrs := []any{
+			slog.String("tool", name),
+			slog.Any("arguments", redactArguments(request.GetArguments())),
+			slog.Duration("duration", time.Since(start)),
+		}
+
+		switch {
+		case err != nil:
+			slog.WarnContext(ctx, "tool call failed", append(attrs, slog.String("error", err.Error()))...)
+		case result != nil && result.IsError:
+			slog.WarnContext(ctx, "tool call returned error", attrs...)
+		default:
+			slog.InfoContext(ctx, "tool call", attrs...)
+		}
+
+		return result, err
+	}
+}
+
+// redactArguments returns the copy of the arguments whose secret values are redacted.
+func redactArguments(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		scrubbed := make(map[string]any, len(value))
+		for name, item := range value {
+			if isSecretArgument(name) {
+				scrubbed[name] = redacted
+				continue
+			}
+
+			scrubbed[name] = redactArguments(item)
+		}
+
+		return scrubbed
+	case []any:
+		scrubbed := make([]any, 0, len(value))
+		for _, item := range value {
+			scrubbed = append(scrubbed, redactArguments(item))
+		}
+
+		return scrubbed
+	default:
+		return value
+	}
+}
+
+func isSecretArgument(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretArguments {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loggingTransport logs each request to GitLab with the status code, the duration and the
+// size of the response body. It is logged when the response body is closed.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.EscapedPath()),
+	}
+
+	if name, ok := ctx.Value(toolNameKey{}).(string); ok {
+		attrs = append([]any{slog.String("tool", name)}, attrs...)
+	}
+
+	start := time.Now()
+	response, err := t.next.RoundTrip(req)
+	if err != nil {
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)), slog.String("error", err.Error()))
+		slog.WarnContext(ctx, "gitlab request failed", attrs...)
+		return nil, err
+	}
+
+	response.Body = &countingBody{
+		ReadCloser: response.Body,
+		closed: func(size int64) {
+			attrs = append(attrs,
+				slog.Int("status", response.StatusCode),
+				slog.Duration("duration", time.Since(start)),
+				slog.Int64("size", size),
+			)
+			slog.InfoContext(ctx, "gitlab request", attrs...)
+		},
+	}
+
+	return response, nil
+}
+
+// countingBody counts the bytes read from the response body, and calls closed once when it is closed.
+type countingBody struct {
+	io.ReadCloser
+	size   int64
+	once   sync.Once
+	closed func(size int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.closed(b.size) })
+	return err
+}
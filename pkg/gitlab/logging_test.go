@@ -0,0 +1,93 @@
+package gitlab_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+type logRecord struct {
+	Level     string         `json:"level"`
+	Msg       string         `json:"msg"`
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+	Method    string         `json:"method"`
+	Path      string         `json:"path"`
+	Status    int            `json:"status"`
+	Size      int            `json:"size"`
+}
+
+func TestLogging(t *testing.T) {
+	var out bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&out, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	fake, project := newFakeProject(t)
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "first"})
+	c, ctx := newTestClient(t, fake, false, server.WithToolHandlerMiddleware(gitlab.LoggingMiddleware))
+
+	issues, _ := callTool(ctx, t, c, "get_pjs_id_issues", map[string]any{"id": "group/project"})
+	callTool(ctx, t, c, "commit_changes", map[string]any{
+		"id":             "group/project",
+		"branch":         "main",
+		"commit_message": "update",
+		"actions":        []map[string]any{{"action": "create", "file_path": "a.txt", "content": "secret-content"}},
+	})
+
+	if strings.Contains(out.String(), "secret-content") || strings.Contains(out.String(), gitlabtest.Token) {
+		t.Fatalf("secret is logged: %s", out.String())
+	}
+
+	records := []logRecord{}
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var record logRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+
+		records = append(records, record)
+	}
+
+	expected := []logRecord{
+		{Level: "INFO", Msg: "gitlab request", Tool: "get_pjs_id_issues", Method: "GET", Path: "/api/v4/projects/group%2Fproject/issues", Status: 200, Size: len(issues)},
+		{Level: "INFO", Msg: "tool call", Tool: "get_pjs_id_issues"},
+		{Level: "INFO", Msg: "gitlab request", Tool: "commit_changes", Method: "POST", Path: "/api/v4/projects/group%2Fproject/repository/commits", Status: 400},
+		{Level: "WARN", Msg: "tool call returned error", Tool: "commit_changes"},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("unexpected records: %s", out.String())
+	}
+
+	for i, record := range records {
+		actual := record
+		actual.Arguments = nil
+		if expected[i].Size == 0 {
+			actual.Size = 0
+		}
+
+		if !reflect.DeepEqual(actual, expected[i]) {
+			t.Errorf("got %+v, want %+v", actual, expected[i])
+		}
+	}
+
+	actions, ok := records[3].Arguments["actions"].([]any)
+	if !ok || len(actions) != 1 {
+		t.Fatalf("unexpected arguments: %v", records[3].Arguments)
+	}
+
+	if action, ok := actions[0].(map[string]any); !ok || action["content"] != "[REDACTED]" {
+		t.Errorf("content is not redacted: %v", records[3].Arguments)
+	}
+}
@@ -0,0 +1,192 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "gitlab_mcp"
+
+// metricsRegistry is the registry of the metrics served by MetricsHandler.
+var metricsRegistry = prometheus.NewRegistry()
+
+var (
+	toolCalls = promauto.With(metricsRegistry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "tool_calls_total",
+		Help:      "Number of tool calls by tool and outcome (success, error or failure).",
+	}, []string{"tool", "outcome"})
+
+	toolCallDuration = promauto.With(metricsRegistry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "tool_call_duration_seconds",
+		Help:      "Duration of tool calls by tool and outcome.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"tool", "outcome"})
+
+	toolCallsInFlight = promauto.With(metricsRegistry).NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "tool_calls_in_flight",
+		Help:      "Number of tool calls being processed.",
+	})
+
+	gitlabRequestDuration = promauto.With(metricsRegistry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "gitlab_request_duration_seconds",
+		Help:      "Duration of GitLab API requests until the response headers are received, by method, route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	gitlabRequestsInFlight = promauto.With(metricsRegistry).NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "gitlab_requests_in_flight",
+		Help:      "Number of GitLab API requests waiting for the response headers.",
+	})
+
+	gitlabRateLimited = promauto.With(metricsRegistry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "gitlab_rate_limited_total",
+		Help:      "Number of GitLab API requests rejected by the rate limit (429 Too Many Requests), by route template.",
+	}, []string{"route"})
+
+	gitlabRateLimitRemaining = promauto.With(metricsRegistry).NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "gitlab_rate_limit_remaining",
+		Help:      "The last 'RateLimit-Remaining' header returned by GitLab.",
+	})
+
+	cacheRequests = promauto.With(metricsRegistry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of cache lookups by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// MetricsHandler returns the handler serving the metrics in the Prometheus exposition format.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{})
+}
+
+// MetricsMiddleware counts the tool calls and observes their durations by tool and outcome.
+func MetricsMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		toolCallsInFlight.Inc()
+		defer toolCallsInFlight.Dec()
+
+		start := time.Now()
+		result, err := next(ctx, request)
+
+		outcome := "success"
+		switch {
+		case err != nil:
+			outcome = "failure"
+		case result != nil && result.IsError:
+			outcome = "error"
+		default:
+		}
+
+		toolCalls.WithLabelValues(request.Params.Name, outcome).Inc()
+		toolCallDuration.WithLabelValues(request.Params.Name, outcome).Observe(time.Since(start).Seconds())
+		return result, err
+	}
+}
+
+// countCache counts the lookup of the cache.
+func countCache(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	cacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// metricsTransport observes the GitLab API requests by method, route template and status.
+type metricsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := routeTemplate(req.URL.EscapedPath())
+
+	gitlabRequestsInFlight.Inc()
+	start := time.Now()
+	response, err := t.next.RoundTrip(req)
+	gitlabRequestsInFlight.Dec()
+
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(response.StatusCode)
+		if response.StatusCode == http.StatusTooManyRequests {
+			gitlabRateLimited.WithLabelValues(route).Inc()
+		}
+
+		if remaining, err := strconv.Atoi(response.Header.Get("RateLimit-Remaining")); err == nil {
+			gitlabRateLimitRemaining.Set(float64(remaining))
+		}
+	}
+
+	gitlabRequestDuration.WithLabelValues(req.Method, route, status).Observe(time.Since(start).Seconds())
+	return response, err
+}
+
+// routeParameters are the path parameters following the resource names.
+var routeParameters = map[string]string{
+	"branches":   "{branch}",
+	"files":      "{file_path}",
+	"groups":     "{id}",
+	"namespaces": "{id}",
+	"projects":   "{id}",
+	"tags":       "{tag_name}",
+	"users":      "{id}",
+	"variables":  "{key}",
+}
+
+var shaPattern = regexp.MustCompile(`^[0-9a-f]{7,64}$`)
+
+// routeTemplate returns the route template of the path such as '/api/v4/projects/{id}/issues/{id}'
+// to keep the cardinality of the metrics low. The path parameters are guessed from the numbers,
+// the escaped values, the commit SHAs and the segments following the resource names such as 'projects'.
+func routeTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	template := make([]string, len(segments))
+	for i, segment := range segments {
+		template[i] = segment
+		if segment == "" {
+			continue
+		}
+
+		if i > 2 {
+			if parameter, ok := routeParameters[segments[i-1]]; ok {
+				template[i] = parameter
+				continue
+			}
+		}
+
+		if _, err := strconv.Atoi(segment); err == nil || strings.Contains(segment, "%") {
+			template[i] = "{id}"
+		} else if shaPattern.MatchString(segment) && strings.ContainsAny(segment, "0123456789") {
+			template[i] = "{sha}"
+		}
+	}
+
+	return strings.Join(template, "/")
+}
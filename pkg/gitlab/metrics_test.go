@@ -0,0 +1,82 @@
+package gitlab_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+// scrapeMetrics returns the values of the metrics by the series such as 'name{label="value"}'.
+func scrapeMetrics(t *testing.T) map[string]float64 {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	gitlab.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", recorder.Code)
+	}
+
+	metrics := map[string]float64{}
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		index := strings.LastIndex(line, " ")
+		value, err := strconv.ParseFloat(line[index+1:], 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		metrics[line[:index]] = value
+	}
+
+	return metrics
+}
+
+func TestMetrics(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "first"})
+	fake.AddFile(project.Id, "main", "docs/README.md", "# README\n")
+	c, ctx := newTestClient(t, fake, true, server.WithToolHandlerMiddleware(gitlab.MetricsMiddleware))
+
+	before := scrapeMetrics(t)
+
+	callTool(ctx, t, c, "get_pjs_id_issues", map[string]any{"id": "group/project"})
+	callTool(ctx, t, c, "get_pjs_id_issues", map[string]any{"id": "group/project"})
+	callTool(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/missing"})
+	callTool(ctx, t, c, "read_repository_files", map[string]any{"id": "group/project", "ref": "main", "files": []map[string]any{{"path": "docs/README.md"}}})
+
+	after := scrapeMetrics(t)
+
+	expected := map[string]float64{
+		`gitlab_mcp_tool_calls_total{outcome="success",tool="get_pjs_id_issues"}`:                                                                    2,
+		`gitlab_mcp_tool_calls_total{outcome="error",tool="get_pjs_id"}`:                                                                             1,
+		`gitlab_mcp_tool_call_duration_seconds_count{outcome="success",tool="get_pjs_id_issues"}`:                                                    2,
+		`gitlab_mcp_gitlab_request_duration_seconds_count{method="GET",route="/api/v4/projects/{id}/issues",status="200"}`:                           2,
+		`gitlab_mcp_gitlab_request_duration_seconds_count{method="GET",route="/api/v4/projects/{id}",status="404"}`:                                  1,
+		`gitlab_mcp_gitlab_request_duration_seconds_count{method="GET",route="/api/v4/projects/{id}/repository/files/{file_path}/raw",status="200"}`: 1,
+	}
+
+	for series, delta := range expected {
+		if actual := after[series] - before[series]; actual != delta {
+			t.Errorf("%s: got %v, want %v", series, actual, delta)
+		}
+	}
+
+	for _, series := range []string{"gitlab_mcp_tool_calls_in_flight", "gitlab_mcp_gitlab_requests_in_flight"} {
+		if after[series] != 0 {
+			t.Errorf("%s: got %v, want 0", series, after[series])
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+var update = flag.Bool("update", false, "update the golden files.")
+
+// maxToolNameLength is the maximum length of the tool name accepted by the clients.
+const maxToolNameLength = 46
+
+// manifestTool is the summary of the tool saved in the golden tool manifest.
+type manifestTool struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Readonly    bool     `json:"readonly"`
+	Properties  []string `json:"properties"`
+	Required    []string `json:"required"`
+	// Enums are the enums of the properties such as 'params.state'.
+	Enums map[string][]any `json:"enums,omitempty"`
+}
+
+type inputSchema struct {
+	Type       string                     `json:"type"`
+	Properties map[string]json.RawMessage `json:"properties"`
+	Required   []string                   `json:"required"`
+}
+
+// propertySchema is the part of the property schema checked by the tests.
+type propertySchema struct {
+	Enum       []any                      `json:"enum"`
+	Default    any                        `json:"default"`
+	Maximum    *float64                   `json:"maximum"`
+	Items      *propertySchema            `json:"items"`
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+// registeredTools returns the tools registered by RegisterTools.
+func registeredTools(t *testing.T, readonly bool) map[string]mcp.Tool {
+	t.Helper()
+
+	s := server.NewMCPServer("GitLab MCP Server", "0.1.0", server.WithToolCapabilities(false))
+	gitlab.RegisterTools(s, readonly)
+
+	tools := map[string]mcp.Tool{}
+	for name, tool := range s.ListTools() {
+		tools[name] = tool.Tool
+	}
+
+	return tools
+}
+
+// declaredToolNames returns the tool names passed to mcp.NewTool in the source files of the package.
+func declaredToolNames(t *testing.T) []string {
+	t.Helper()
+
+	sources, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	fset := token.NewFileSet()
+	for _, source := range sources {
+		if strings.HasSuffix(source, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, source, nil, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+
+			fn, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || fn.Sel.Name != "NewTool" {
+				return true
+			}
+
+			if pkg, ok := fn.X.(*ast.Ident); !ok || pkg.Name != "mcp" {
+				return true
+			}
+
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				t.Errorf("%s: tool name is not a string literal", fset.Position(call.Pos()))
+				return true
+			}
+
+			name, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			names = append(names, name)
+			return true
+		})
+	}
+
+	return names
+}
+
+// toolSchema returns the input schema of the tool as sent to the clients.
+func toolSchema(t *testing.T, tool mcp.Tool) []byte {
+	t.Helper()
+
+	content, err := json.Marshal(tool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		InputSchema json.RawMessage `json:"inputSchema"`
+	}
+	if err := json.Unmarshal(content, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v.InputSchema
+}
+
+// propertyEnums collects the enums of the properties and the nested properties
+// by the dotted names such as 'params.state'. The enum of the array is taken from the items.
+func propertyEnums(t *testing.T, prefix string, properties map[string]json.RawMessage, enums map[string][]any) {
+	t.Helper()
+
+	for name, content := range properties {
+		var property propertySchema
+		if err := json.Unmarshal(content, &property); err != nil {
+			t.Fatal(err)
+		}
+
+		enum := property.Enum
+		if property.Items != nil {
+			enum = property.Items.Enum
+		}
+
+		if enum != nil {
+			enums[prefix+name] = enum
+		}
+
+		propertyEnums(t, prefix+name+".", property.Properties, enums)
+	}
+}
+
+// isPathParameter reports whether the property is a path parameter of the tool.
+// The tool name of the REST API endpoint contains the path parameters such as
+// 'job_id' in 'get_pjs_id_jobs_job_id', unless the words are abbreviated.
+func isPathParameter(name string, property string) bool {
+	if property == "params" || property == "body" {
+		return false
+	}
+
+	return strings.Contains(name+"_", "_"+property+"_")
+}
+
+func TestToolNamesUnique(t *testing.T) {
+	declared := declaredToolNames(t)
+
+	seen := map[string]bool{}
+	for _, name := range declared {
+		if seen[name] {
+			t.Errorf("%s is declared more than once", name)
+		}
+
+		seen[name] = true
+	}
+
+	// A tool vanishes silently if its schema can not be reflected.
+	registered := registeredTools(t, false)
+	for name := range seen {
+		if _, ok := registered[name]; !ok {
+			t.Errorf("%s is not registered", name)
+		}
+	}
+
+	if len(registered) != len(seen) {
+		t.Errorf("%d tools are registered, %d tools are declared", len(registered), len(seen))
+	}
+}
+
+func TestToolSchemas(t *testing.T) {
+	modes := []struct {
+		name     string
+		readonly bool
+	}{
+		{name: "readonly", readonly: true},
+		{name: "read-write", readonly: false},
+	}
+
+	for _, mode := range modes {
+		t.Run(mode.name, func(t *testing.T) {
+			tools := registeredTools(t, mode.readonly)
+			if len(tools) == 0 {
+				t.Fatal("no tools are registered")
+			}
+
+			for name, tool := range tools {
+				if maxToolNameLength < len(name) {
+					t.Errorf("%s exceeds %d characters", name, maxToolNameLength)
+				}
+
+				if strings.TrimSpace(tool.Description) == "" {
+					t.Errorf("%s has no description", name)
+				}
+
+				content := toolSchema(t, tool)
+
+				doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+				if err != nil {
+					t.Errorf("%s: %v", name, err)
+					continue
+				}
+
+				compiler := jsonschema.NewCompiler()
+				if err := compiler.AddResource(name+".json", doc); err != nil {
+					t.Errorf("%s: %v", name, err)
+					continue
+				}
+
+				if _, err := compiler.Compile(name + ".json"); err != nil {
+					t.Errorf("%s: invalid schema: %v", name, err)
+					continue
+				}
+
+				var schema inputSchema
+				if err := json.Unmarshal(content, &schema); err != nil {
+					t.Fatal(err)
+				}
+
+				if schema.Type != "object" {
+					t.Errorf("%s: schema type is %q", name, schema.Type)
+				}
+
+				for property := range schema.Properties {
+					if isPathParameter(name, property) && !slices.Contains(schema.Required, property) {
+						t.Errorf("%s: path parameter %s is not required", name, property)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestToolSchemaConstraints(t *testing.T) {
+	tools := registeredTools(t, true)
+
+	property := func(name string, path ...string) propertySchema {
+		t.Helper()
+
+		tool, ok := tools[name]
+		if !ok {
+			t.Fatalf("%s is not registered", name)
+		}
+
+		var schema inputSchema
+		if err := json.Unmarshal(toolSchema(t, tool), &schema); err != nil {
+			t.Fatal(err)
+		}
+
+		var property propertySchema
+		properties := schema.Properties
+		for _, p := range path {
+			content, ok := properties[p]
+			if !ok {
+				t.Fatalf("%s has no property %s", name, strings.Join(path, "."))
+			}
+
+			property = propertySchema{}
+			if err := json.Unmarshal(content, &property); err != nil {
+				t.Fatal(err)
+			}
+
+			properties = property.Properties
+		}
+
+		return property
+	}
+
+	state := property("get_pjs_id_issues", "params", "state")
+	if !reflect.DeepEqual(state.Enum, []any{"opened", "closed", "all"}) {
+		t.Errorf("get_pjs_id_issues params.state enum = %v", state.Enum)
+	}
+
+	sort := property("get_pjs", "params", "sort")
+	if !reflect.DeepEqual(sort.Enum, []any{"asc", "desc"}) || sort.Default != "desc" {
+		t.Errorf("get_pjs params.sort = %+v", sort)
+	}
+
+	perPage := property("get_pjs", "params", "per_page")
+	if perPage.Maximum == nil || *perPage.Maximum != 100 {
+		t.Errorf("get_pjs params.per_page maximum = %v", perPage.Maximum)
+	}
+
+	scope := property("get_pjs_id_jobs", "params", "scope")
+	if scope.Items == nil || !slices.Contains(scope.Items.Enum, any("failed")) {
+		t.Errorf("get_pjs_id_jobs params.scope items = %+v", scope.Items)
+	}
+}
+
+func TestToolManifest(t *testing.T) {
+	readonly := registeredTools(t, true)
+
+	manifest := []manifestTool{}
+	for name, tool := range registeredTools(t, false) {
+		var schema inputSchema
+		if err := json.Unmarshal(toolSchema(t, tool), &schema); err != nil {
+			t.Fatal(err)
+		}
+
+		properties := []string{}
+		for property := range schema.Properties {
+			properties = append(properties, property)
+		}
+
+		required := slices.Clone(schema.Required)
+		if required == nil {
+			required = []string{}
+		}
+
+		slices.Sort(properties)
+		slices.Sort(required)
+
+		enums := map[string][]any{}
+		propertyEnums(t, "", schema.Properties, enums)
+
+		_, ok := readonly[name]
+		manifest = append(manifest, manifestTool{
+			Name:        name,
+			Description: tool.Description,
+			Readonly:    ok,
+			Properties:  properties,
+			Required:    required,
+			Enums:       enums,
+		})
+	}
+
+	slices.SortFunc(manifest, func(a, b manifestTool) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	actual, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual = append(actual, '\n')
+
+	golden := filepath.Join("testdata", "tools.json.golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(golden, actual, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v: run 'go test ./pkg/gitlab -run TestToolManifest -update'", err)
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("tool manifest differs from %s: run 'go test ./pkg/gitlab -run TestToolManifest -update'", golden)
+	}
+}
@@ -0,0 +1,785 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	mcpclient "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab/gitlabtest"
+)
+
+// newTestClient starts the MCP server with all tools and returns the client connected in-process
+// and the context to call the tools against the fake GitLab server.
+func newTestClient(t *testing.T, fake *gitlabtest.Server, readonly bool, options ...server.ServerOption) (*mcpclient.Client, context.Context) {
+	t.Helper()
+
+	options = append([]server.ServerOption{server.WithToolCapabilities(false)}, options...)
+	s := server.NewMCPServer("GitLab MCP Server", "0.1.0", options...)
+	gitlab.RegisterTools(s, readonly)
+
+	return connectTestClient(t, s, fake)
+}
+
+// connectTestClient returns the client connected to the MCP server in-process and the context
+// to call the tools against the fake GitLab server.
+func connectTestClient(t *testing.T, s *server.MCPServer, fake *gitlabtest.Server) (*mcpclient.Client, context.Context) {
+	t.Helper()
+
+	c, err := mcpclient.NewInProcessClient(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = c.Close() })
+
+	ctx := t.Context()
+	if err := c.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	request := mcp.InitializeRequest{}
+	request.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	request.Params.ClientInfo = mcp.Implementation{Name: "gitlab-mcp-server-test", Version: "0.1.0"}
+	if _, err := c.Initialize(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = context.WithValue(ctx, gitlab.UrlKey{}, fake.URL)
+	ctx = context.WithValue(ctx, gitlab.TokenKey{}, gitlabtest.Token)
+	return c, ctx
+}
+
+// callTool calls the tool and returns the text content and whether the result is an error.
+func callTool(ctx context.Context, t *testing.T, c *mcpclient.Client, name string, arguments map[string]any) (text string, isError bool) {
+	t.Helper()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = name
+	request.Params.Arguments = arguments
+
+	result, err := c.CallTool(ctx, request)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+
+	texts := []string{}
+	for _, content := range result.Content {
+		if c, ok := content.(mcp.TextContent); ok {
+			texts = append(texts, c.Text)
+		}
+	}
+
+	return strings.Join(texts, "\n"), result.IsError
+}
+
+// callToolJSON calls the tool and decodes the JSON result into v. The test fails if the result is an error.
+func callToolJSON(ctx context.Context, t *testing.T, c *mcpclient.Client, name string, arguments map[string]any, v any) {
+	t.Helper()
+
+	text, isError := callTool(ctx, t, c, name, arguments)
+	if isError {
+		t.Fatalf("%s: %s", name, text)
+	}
+
+	if err := json.Unmarshal([]byte(text), v); err != nil {
+		t.Fatalf("%s: %v: %s", name, err, text)
+	}
+}
+
+func newFakeProject(t *testing.T) (*gitlabtest.Server, *gitlabtest.Project) {
+	t.Helper()
+
+	fake := gitlabtest.NewServer(t)
+	project := fake.AddProject(gitlabtest.Project{Name: "project", PathWithNamespace: "group/project"})
+	return fake, project
+}
+
+func TestAuthorizationHeader(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	var version map[string]string
+	callToolJSON(ctx, t, c, "get_version", nil, &version)
+
+	if version["version"] == "" {
+		t.Errorf("version is empty: %v", version)
+	}
+
+	r := fake.LastRequest()
+	if got := r.Header.Get("Authorization"); got != "Bearer "+gitlabtest.Token {
+		t.Errorf("Authorization = %q", got)
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	ctx = context.WithValue(ctx, gitlab.TokenKey{}, "invalid")
+	text, isError := callTool(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/project"})
+	if !isError || !strings.Contains(text, "401 Unauthorized") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+}
+
+func TestMissingToken(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	ctx = context.WithValue(ctx, gitlab.TokenKey{}, "")
+	text, isError := callTool(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/project"})
+	if !isError || !strings.Contains(text, "missing token") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("%d requests are sent without token", len(requests))
+	}
+}
+
+func TestPathEncoding(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "docs/README.md", "# Project\n")
+	c, ctx := newTestClient(t, fake, true)
+
+	var p gitlabtest.Project
+	callToolJSON(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/project"}, &p)
+
+	if p.Id != project.Id {
+		t.Errorf("project = %+v", p)
+	}
+
+	if path := fake.LastRequest().Path; path != "/api/v4/projects/group%2Fproject" {
+		t.Errorf("path = %s", path)
+	}
+
+	text, isError := callTool(ctx, t, c, "get_pjs_id_repo_files_file_path_raw", map[string]any{
+		"id":        "group/project",
+		"file_path": "docs/README.md",
+		"params":    map[string]any{"ref": "main"},
+	})
+	if isError || text != "# Project\n" {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	r := fake.LastRequest()
+	if r.Path != "/api/v4/projects/group%2Fproject/repository/files/docs%2FREADME.md/raw" {
+		t.Errorf("path = %s", r.Path)
+	}
+
+	if ref := r.Query.Get("ref"); ref != "main" {
+		t.Errorf("ref = %s", ref)
+	}
+}
+
+func TestQueryParameters(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddProject(gitlabtest.Project{Name: "other", PathWithNamespace: "group/other"})
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "bug", Labels: []string{"bug"}})
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "closed bug", Labels: []string{"bug"}, State: "closed"})
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "feature", Labels: []string{"feature"}})
+	c, ctx := newTestClient(t, fake, true)
+
+	var projects []gitlabtest.Project
+	callToolJSON(ctx, t, c, "get_pjs", map[string]any{
+		"params": map[string]any{"search": "other", "per_page": 10},
+	}, &projects)
+
+	if len(projects) != 1 || projects[0].Name != "other" {
+		t.Errorf("projects = %+v", projects)
+	}
+
+	query := fake.LastRequest().Query
+	if query.Get("search") != "other" || query.Get("per_page") != "10" {
+		t.Errorf("query = %v", query)
+	}
+
+	var issues []gitlabtest.Issue
+	callToolJSON(ctx, t, c, "get_pjs_id_issues", map[string]any{
+		"id":     "group/project",
+		"params": map[string]any{"state": "opened", "labels": []string{"bug"}},
+	}, &issues)
+
+	if len(issues) != 1 || issues[0].Title != "bug" {
+		t.Errorf("issues = %+v", issues)
+	}
+
+	query = fake.LastRequest().Query
+	if query.Get("state") != "opened" || query.Get("labels") != "bug" {
+		t.Errorf("query = %v", query)
+	}
+}
+
+func TestErrorMapping(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	tests := []struct {
+		name      string
+		tool      string
+		arguments map[string]any
+		expected  string
+	}{
+		{
+			name:      "project not found",
+			tool:      "get_pjs_id",
+			arguments: map[string]any{"id": "group/missing"},
+			expected:  "404 Not Found: {\"message\":\"404 Project Not Found\"}",
+		},
+		{
+			name:      "issue not found",
+			tool:      "get_pjs_id_issues_issue_iid",
+			arguments: map[string]any{"id": "group/project", "issue_iid": 1},
+			expected:  "404 Not Found",
+		},
+		{
+			name:      "file not found",
+			tool:      "get_pjs_id_repo_files_file_path_raw",
+			arguments: map[string]any{"id": "group/project", "file_path": "missing.txt"},
+			expected:  "404 File Not Found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, isError := callTool(ctx, t, c, tt.tool, tt.arguments)
+			if !isError || !strings.Contains(text, tt.expected) {
+				t.Errorf("unexpected result: %v %s", isError, text)
+			}
+		})
+	}
+}
+
+func TestReadonly(t *testing.T) {
+	fake, _ := newFakeProject(t)
+
+	c, ctx := newTestClient(t, fake, true)
+	tools, err := c.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tool := range tools.Tools {
+		if strings.HasPrefix(tool.Name, "post_") || strings.HasPrefix(tool.Name, "put_") || strings.HasPrefix(tool.Name, "delete_") {
+			t.Errorf("%s is registered in readonly mode", tool.Name)
+		}
+	}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "post_pjs_id_issues"
+	request.Params.Arguments = map[string]any{"id": "group/project"}
+	if _, err := c.CallTool(ctx, request); err == nil {
+		t.Errorf("post_pjs_id_issues is called in readonly mode")
+	}
+
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("%d requests are sent in readonly mode", len(requests))
+	}
+}
+
+func TestFilterTools(t *testing.T) {
+	s := server.NewMCPServer("GitLab MCP Server", "0.1.0")
+	gitlab.RegisterTools(s, true)
+
+	if err := gitlab.FilterTools(s, []string{"get_pjs_id_issues*", "get_version"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range s.ListTools() {
+		if name != "get_version" && !strings.HasPrefix(name, "get_pjs_id_issues") {
+			t.Errorf("%s is not filtered", name)
+		}
+	}
+
+	if _, ok := s.ListTools()["get_pjs_id_issues"]; !ok {
+		t.Errorf("get_pjs_id_issues is filtered")
+	}
+
+	if err := gitlab.FilterTools(s, []string{"missing_*"}); err == nil {
+		t.Errorf("no error for the pattern matching no tools")
+	}
+
+	if err := gitlab.FilterTools(s, []string{"get_["}); err == nil {
+		t.Errorf("no error for the invalid pattern")
+	}
+}
+
+func TestArgumentValidation(t *testing.T) {
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true)
+
+	tests := []struct {
+		name      string
+		tool      string
+		arguments map[string]any
+		expected  string
+	}{
+		{
+			name:      "missing path parameter",
+			tool:      "get_pjs_id_issues",
+			arguments: map[string]any{},
+			expected:  "invalid arguments: at '': missing property 'id'",
+		},
+		{
+			name:      "wrong type",
+			tool:      "get_job_log",
+			arguments: map[string]any{"id": "group/project", "job_id": "latest"},
+			expected:  "invalid arguments: at '/job_id': got string, want integer",
+		},
+		{
+			name:      "below minimum",
+			tool:      "get_job_log",
+			arguments: map[string]any{"id": "group/project", "job_id": 1, "tail": -1},
+			expected:  "invalid arguments: at '/tail': minimum: got -1, want 0",
+		},
+		{
+			name:      "not in enum",
+			tool:      "get_pjs_id_issues",
+			arguments: map[string]any{"id": "group/project", "params": map[string]any{"state": "open"}},
+			expected:  "invalid arguments: at '/params/state': value must be one of 'opened', 'closed', 'all'",
+		},
+		{
+			name:      "above maximum",
+			tool:      "get_pjs",
+			arguments: map[string]any{"params": map[string]any{"per_page": 101}},
+			expected:  "invalid arguments: at '/params/per_page': maximum: got 101, want 100",
+		},
+		{
+			name:      "unknown property",
+			tool:      "get_pjs_id",
+			arguments: map[string]any{"id": "group/project", "project": "group/project"},
+			expected:  "invalid arguments: at '': additional properties 'project' not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, isError := callTool(ctx, t, c, tt.tool, tt.arguments)
+			if !isError || text != tt.expected {
+				t.Errorf("got %v %s, want %s", isError, text, tt.expected)
+			}
+		})
+	}
+
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("%d requests are sent with invalid arguments", len(requests))
+	}
+}
+
+func TestCreateIssue(t *testing.T) {
+	fake, project := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, false)
+
+	var issue gitlabtest.Issue
+	callToolJSON(ctx, t, c, "post_pjs_id_issues", map[string]any{
+		"id":     "group/project",
+		"params": map[string]any{"title": "new issue", "labels": []string{"bug", "ui"}},
+	}, &issue)
+
+	if issue.Iid != 1 || issue.Title != "new issue" || strings.Join(issue.Labels, ",") != "bug,ui" {
+		t.Errorf("issue = %+v", issue)
+	}
+
+	r := fake.LastRequest()
+	if r.Method != "POST" || r.Path != "/api/v4/projects/group%2Fproject/issues" {
+		t.Errorf("request = %s %s", r.Method, r.Path)
+	}
+
+	var created gitlabtest.Issue
+	callToolJSON(ctx, t, c, "get_pjs_id_issues_issue_iid", map[string]any{"id": strconv.Itoa(project.Id), "issue_iid": 1}, &created)
+
+	if created.Id != issue.Id {
+		t.Errorf("issue = %+v", created)
+	}
+}
+
+func TestProposeChangeRollback(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "README.md", "readme\n")
+	c, ctx := newTestClient(t, fake, false)
+
+	text, isError := callTool(ctx, t, c, "propose_change", map[string]any{
+		"id":     "group/project",
+		"branch": "feature",
+		"title":  "Update missing file",
+		"actions": []map[string]any{
+			{"action": "update", "file_path": "missing.txt", "content": "content\n"},
+		},
+	})
+	if !isError || !strings.Contains(text, "commit: ") || !strings.Contains(text, "branch feature is deleted") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	last := fake.LastRequest()
+	if last.Method != http.MethodDelete || last.Path != "/api/v4/projects/group%2Fproject/repository/branches/feature" {
+		t.Errorf("last request = %s %s", last.Method, last.Path)
+	}
+
+	for _, r := range fake.Requests() {
+		if strings.HasSuffix(r.Path, "/merge_requests") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.Path)
+		}
+	}
+}
+
+type boardListIssues struct {
+	Name   string             `json:"name"`
+	Issues []gitlabtest.Issue `json:"issues"`
+}
+
+func TestBoardIssuesScope(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "in scope", Labels: []string{"backend", "doing"}})
+	fake.AddIssue(project.Id, gitlabtest.Issue{Title: "out of scope", Labels: []string{"doing"}})
+	weight := 3
+	board := fake.AddBoard(project.Id, gitlabtest.Board{
+		Name:      "backend",
+		Milestone: &gitlabtest.Milestone{Id: 1, Title: "v1.0"},
+		Assignee:  &gitlabtest.User{Id: 1, Username: "alice"},
+		Labels:    []gitlabtest.Label{{Id: 1, Name: "backend"}},
+		Weight:    &weight,
+		Lists: []gitlabtest.BoardList{
+			{ListType: "label", Position: 0, Label: &gitlabtest.Label{Id: 2, Name: "doing"}},
+			{ListType: "iteration", Position: 1},
+		},
+	})
+	c, ctx := newTestClient(t, fake, true)
+
+	var lists []boardListIssues
+	callToolJSON(ctx, t, c, "get_board_issues", map[string]any{
+		"project_id": "group/project",
+		"board_id":   board.Id,
+	}, &lists)
+
+	if len(lists) != 2 || lists[0].Name != "doing" || len(lists[1].Issues) != 0 {
+		t.Fatalf("lists = %+v", lists)
+	}
+
+	if len(lists[0].Issues) != 1 || lists[0].Issues[0].Title != "in scope" {
+		t.Errorf("issues = %+v", lists[0].Issues)
+	}
+
+	var query url.Values
+	for _, r := range fake.Requests() {
+		if strings.HasSuffix(r.Path, "/issues") {
+			query = r.Query
+		}
+	}
+
+	want := map[string]string{
+		"labels":            "backend,doing",
+		"milestone":         "v1.0",
+		"assignee_username": "alice",
+		"weight":            "3",
+		"state":             "opened",
+	}
+	for key, value := range want {
+		if query.Get(key) != value {
+			t.Errorf("%s = %q, want %q", key, query.Get(key), value)
+		}
+	}
+}
+
+func TestListMergeRequests(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddMergeRequest(project.Id, gitlabtest.MergeRequest{Title: "opened", SourceBranch: "feature", TargetBranch: "main"})
+	fake.AddMergeRequest(project.Id, gitlabtest.MergeRequest{Title: "merged", State: "merged"})
+	c, ctx := newTestClient(t, fake, true)
+
+	var mergeRequests []gitlabtest.MergeRequest
+	callToolJSON(ctx, t, c, "get_pjs_id_mrs", map[string]any{
+		"id":     "group/project",
+		"params": map[string]any{"state": "merged"},
+	}, &mergeRequests)
+
+	if len(mergeRequests) != 1 || mergeRequests[0].Title != "merged" || mergeRequests[0].Iid != 2 {
+		t.Errorf("merge requests = %+v", mergeRequests)
+	}
+}
+
+type reviewContext struct {
+	Files            []map[string]any `json:"files"`
+	FilesTruncated   bool             `json:"files_truncated"`
+	Commits          []map[string]any `json:"commits"`
+	CommitsTruncated bool             `json:"commits_truncated"`
+}
+
+func TestMrReviewContextTruncated(t *testing.T) {
+	diffs := []gitlabtest.Diff{}
+	for _, name := range []string{"a.go", "b.go", "c.go"} {
+		diffs = append(diffs, gitlabtest.Diff{OldPath: name, NewPath: name, Diff: "@@ -1 +1 @@\n-a\n+b\n"})
+	}
+
+	fake, project := newFakeProject(t)
+	fake.AddMergeRequest(project.Id, gitlabtest.MergeRequest{Title: "change", Diffs: diffs})
+	c, ctx := newTestClient(t, fake, true)
+
+	tests := []struct {
+		maxFiles  int
+		files     int
+		truncated bool
+	}{
+		{maxFiles: 2, files: 2, truncated: true},
+		{maxFiles: 3, files: 3, truncated: false},
+		{maxFiles: 4, files: 3, truncated: false},
+	}
+
+	for _, tt := range tests {
+		var result reviewContext
+		callToolJSON(ctx, t, c, "get_mr_review_context", map[string]any{
+			"id":                "group/project",
+			"merge_request_iid": 1,
+			"max_files":         tt.maxFiles,
+		}, &result)
+
+		if len(result.Files) != tt.files || result.FilesTruncated != tt.truncated {
+			t.Errorf("max_files %d: %d files, truncated %v", tt.maxFiles, len(result.Files), result.FilesTruncated)
+		}
+	}
+}
+
+func TestMrReviewContextCommits(t *testing.T) {
+	commits := []gitlabtest.Commit{}
+	for i := range 250 {
+		id := "commit-" + strconv.Itoa(i)
+		commits = append(commits, gitlabtest.Commit{Id: id, ShortId: id, Title: "change " + strconv.Itoa(i)})
+	}
+
+	fake, project := newFakeProject(t)
+	fake.AddMergeRequest(project.Id, gitlabtest.MergeRequest{Title: "change", Commits: commits})
+	c, ctx := newTestClient(t, fake, true)
+
+	tests := []struct {
+		maxCommits int
+		commits    int
+		truncated  bool
+	}{
+		{maxCommits: 0, commits: 100, truncated: true},
+		{maxCommits: 249, commits: 249, truncated: true},
+		{maxCommits: 250, commits: 250, truncated: false},
+	}
+
+	for _, tt := range tests {
+		arguments := map[string]any{"id": "group/project", "merge_request_iid": 1}
+		if tt.maxCommits != 0 {
+			arguments["max_commits"] = tt.maxCommits
+		}
+
+		var result reviewContext
+		callToolJSON(ctx, t, c, "get_mr_review_context", arguments, &result)
+
+		if len(result.Commits) != tt.commits || result.CommitsTruncated != tt.truncated {
+			t.Errorf("max_commits %d: %d commits, truncated %v", tt.maxCommits, len(result.Commits), result.CommitsTruncated)
+		}
+	}
+}
+
+func TestJobLog(t *testing.T) {
+	fake, project := newFakeProject(t)
+	pipeline := fake.AddPipeline(project.Id, gitlabtest.Pipeline{Ref: "main", Sha: "abc", Status: "failed"})
+	job := fake.AddJob(pipeline.Id, gitlabtest.Job{
+		Name:   "test",
+		Stage:  "test",
+		Status: "failed",
+		Trace:  "go test ./...\n--- FAIL: TestX\nFAIL\nERROR: Job failed: exit code 1\n",
+	})
+	c, ctx := newTestClient(t, fake, true)
+
+	text, isError := callTool(ctx, t, c, "get_job_log", map[string]any{
+		"id":     "group/project",
+		"job_id": job.Id,
+		"grep":   "FAIL",
+	})
+	if isError || !strings.Contains(text, "--- FAIL: TestX") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+
+	if path := fake.LastRequest().Path; !strings.HasSuffix(path, "/jobs/"+strconv.Itoa(job.Id)+"/trace") {
+		t.Errorf("path = %s", path)
+	}
+}
+
+type diagnosedJob struct {
+	Name       string   `json:"name"`
+	ErrorLines []string `json:"error_lines"`
+}
+
+type diagnosis struct {
+	Pipeline   gitlabtest.Pipeline `json:"pipeline"`
+	FailedJobs []diagnosedJob      `json:"failed_jobs"`
+}
+
+func TestDiagnosePipeline(t *testing.T) {
+	fake, project := newFakeProject(t)
+	pipeline := fake.AddPipeline(project.Id, gitlabtest.Pipeline{Ref: "main", Sha: "abc", Status: "failed"})
+	fake.AddJob(pipeline.Id, gitlabtest.Job{Name: "build", Stage: "build"})
+	fake.AddJob(pipeline.Id, gitlabtest.Job{
+		Name:          "test",
+		Stage:         "test",
+		Status:        "failed",
+		FailureReason: "script_failure",
+		Trace:         "ok\nERROR: Job failed: exit code 1\n",
+	})
+	c, ctx := newTestClient(t, fake, true)
+
+	var diagnosis diagnosis
+	callToolJSON(ctx, t, c, "diagnose_pipeline", map[string]any{"id": "group/project"}, &diagnosis)
+
+	if diagnosis.Pipeline.Id != pipeline.Id || diagnosis.Pipeline.Status != "failed" {
+		t.Errorf("pipeline = %+v", diagnosis.Pipeline)
+	}
+
+	if len(diagnosis.FailedJobs) != 1 || diagnosis.FailedJobs[0].Name != "test" {
+		t.Fatalf("failed jobs = %+v", diagnosis.FailedJobs)
+	}
+}
+
+type listedFile struct {
+	Path string `json:"path"`
+}
+
+type listedFiles struct {
+	Files []listedFile `json:"files"`
+}
+
+func TestReadRepositoryFiles(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "go.mod", "module example\n")
+	fake.AddFile(project.Id, "main", "cmd/main.go", "package main\n")
+	fake.AddFile(project.Id, "main", "pkg/lib.go", "package pkg\n")
+	c, ctx := newTestClient(t, fake, true)
+
+	var listed listedFiles
+	callToolJSON(ctx, t, c, "list_repository_files", map[string]any{
+		"id":      "group/project",
+		"include": []string{"**/*.go"},
+	}, &listed)
+
+	paths := []string{}
+	for _, f := range listed.Files {
+		paths = append(paths, f.Path)
+	}
+
+	if strings.Join(paths, ",") != "cmd/main.go,pkg/lib.go" {
+		t.Errorf("files = %v", paths)
+	}
+
+	text, isError := callTool(ctx, t, c, "read_repository_files", map[string]any{
+		"id":    "group/project",
+		"files": []map[string]any{{"path": "go.mod"}},
+	})
+	if isError || !strings.Contains(text, "module example") {
+		t.Errorf("unexpected result: %v %s", isError, text)
+	}
+}
+
+type readFile struct {
+	Path      string `json:"path"`
+	BlobId    string `json:"blob_id"`
+	Size      int    `json:"size"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated"`
+	Skipped   string `json:"skipped"`
+}
+
+type readFiles struct {
+	Files []readFile `json:"files"`
+}
+
+func TestReadRepositoryFilesContent(t *testing.T) {
+	// The 8000th byte is in the middle of a multibyte character.
+	multibyte := "a" + strings.Repeat("漢字\n", 1500)
+	lines := ""
+	for i := 1; i <= 1000; i++ {
+		lines += "line " + strconv.Itoa(i) + "\n"
+	}
+
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "multibyte.txt", multibyte)
+	fake.AddFile(project.Id, "main", "lines.txt", lines)
+	fake.AddFile(project.Id, "main", "tail.txt", lines)
+	fake.AddFile(project.Id, "main", "image.png", "\x89PNG\r\n\x1a\n\x00\x00")
+	c, ctx := newTestClient(t, fake, true)
+
+	var result readFiles
+	callToolJSON(ctx, t, c, "read_repository_files", map[string]any{
+		"id":  "group/project",
+		"ref": "main",
+		"files": []map[string]any{
+			{"path": "multibyte.txt"},
+			{"path": "lines.txt", "start_line": 10, "end_line": 12},
+			{"path": "tail.txt", "start_line": 999},
+			{"path": "image.png"},
+		},
+		"line_numbers": true,
+	}, &result)
+
+	expected := []readFile{
+		{Path: "multibyte.txt", StartLine: 1, EndLine: 1500},
+		{Path: "lines.txt", StartLine: 10, EndLine: 12, Content: "10\tline 10\n11\tline 11\n12\tline 12\n"},
+		{Path: "tail.txt", StartLine: 999, EndLine: 1000, Content: "999\tline 999\n1000\tline 1000\n"},
+		{Path: "image.png", Skipped: "binary file"},
+	}
+
+	if len(result.Files) != len(expected) {
+		t.Fatalf("files = %+v", result.Files)
+	}
+
+	for i, file := range result.Files {
+		if file.Size == 0 || file.BlobId == "" {
+			t.Errorf("%s: size and blob ID are missing: %+v", file.Path, file)
+		}
+
+		file.Size, file.BlobId = 0, ""
+		if i == 0 {
+			if !strings.HasPrefix(file.Content, "1\ta漢字\n2\t漢字\n") || file.Truncated {
+				t.Errorf("multibyte text is not read: %+v", file.Skipped)
+			}
+
+			file.Content = ""
+		}
+
+		if file != expected[i] {
+			t.Errorf("got %+v, want %+v", file, expected[i])
+		}
+	}
+}
+
+func TestReadRepositoryFilesLimit(t *testing.T) {
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "lines.txt", strings.Repeat("0123456789\n", 1000))
+	fake.AddFile(project.Id, "main", "long.txt", strings.Repeat("漢", 100))
+	c, ctx := newTestClient(t, fake, true)
+
+	var result readFiles
+	callToolJSON(ctx, t, c, "read_repository_files", map[string]any{
+		"id":             "group/project",
+		"ref":            "main",
+		"files":          []map[string]any{{"path": "lines.txt"}, {"path": "long.txt"}},
+		"max_file_bytes": 25,
+	}, &result)
+
+	expected := []readFile{
+		{Path: "lines.txt", StartLine: 1, EndLine: 2, Content: "0123456789\n0123456789\n", Truncated: true},
+		// The line longer than the limit is cut at the character boundary.
+		{Path: "long.txt", StartLine: 1, EndLine: 1, Content: strings.Repeat("漢", 8), Truncated: true},
+	}
+
+	for i, file := range result.Files {
+		file.Size, file.BlobId = 0, ""
+		if i < len(expected) && file != expected[i] {
+			t.Errorf("got %+v, want %+v", file, expected[i])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+
+// TracingMiddleware starts a span for each tool call with the global tracer provider.
+// The trace context such as 'traceparent' in '_meta' of the request is continued, so that
+// the tool call is joined to the trace of the client. The requests to GitLab sent by the
+// tool are traced as the child spans.
+func TracingMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if request.Params.Meta != nil {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, metaCarrier(request.Params.Meta.AdditionalFields))
+		}
+
+		name := request.Params.Name
+		ctx, span := otel.Tracer(tracerName).Start(ctx, "tools/call "+name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.GenAIOperationNameExecuteTool,
+				semconv.GenAIToolName(name),
+			),
+		)
+		defer span.End()
+
+		result, err := next(ctx, request)
+
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case result != nil && result.IsError:
+			span.SetStatus(codes.Error, "tool call returned error")
+		default:
+		}
+
+		return result, err
+	}
+}
+
+// metaCarrier reads the trace context from '_meta' of the request.
+type metaCarrier map[string]any
+
+func (c metaCarrier) Get(key string) string {
+	if value, ok := c[key].(string); ok {
+		return value
+	}
+
+	return ""
+}
+
+func (c metaCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+func (c metaCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// tracingTransport traces each request to GitLab as a client span named by the method and
+// the route template, and propagates the trace context to GitLab with the request headers.
+// The span ends when the response body is closed.
+type tracingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := routeTemplate(req.URL.EscapedPath())
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), req.Method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLTemplate(route),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	response, err := t.next.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetAttributes(semconv.ErrorTypeKey.String(strconv.Itoa(response.StatusCode)))
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", response.StatusCode))
+	}
+
+	response.Body = &countingBody{
+		ReadCloser: response.Body,
+		closed: func(size int64) {
+			span.SetAttributes(semconv.HTTPResponseBodySize(int(size)))
+			span.End()
+		},
+	}
+
+	return response, nil
+}
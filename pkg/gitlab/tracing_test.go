@@ -0,0 +1,114 @@
+package gitlab_test
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	fake, project := newFakeProject(t)
+	fake.AddFile(project.Id, "main", "a.txt", "a\n")
+	fake.AddFile(project.Id, "main", "b.txt", "b\n")
+	c, ctx := newTestClient(t, fake, true, server.WithToolHandlerMiddleware(gitlab.TracingMiddleware))
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "read_repository_files"
+	request.Params.Arguments = map[string]any{
+		"id":    "group/project",
+		"ref":   "main",
+		"files": []map[string]any{{"path": "a.txt"}, {"path": "b.txt"}},
+	}
+	request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{"traceparent": traceparent}}
+
+	result, err := c.CallTool(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.IsError {
+		t.Fatalf("unexpected error: %v", result.Content)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("unexpected spans: %v", spans)
+	}
+
+	call := spans[len(spans)-1]
+	if call.Name != "tools/call read_repository_files" || call.SpanKind != trace.SpanKindServer {
+		t.Errorf("unexpected tool call span: %s %v", call.Name, call.SpanKind)
+	}
+
+	if call.Parent.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || call.Parent.SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("traceparent is not continued: %v", call.Parent)
+	}
+
+	for _, span := range spans[:len(spans)-1] {
+		if span.Name != "GET /api/v4/projects/{id}/repository/files/{file_path}/raw" || span.SpanKind != trace.SpanKindClient {
+			t.Errorf("unexpected request span: %s %v", span.Name, span.SpanKind)
+		}
+
+		if span.Parent.SpanID() != call.SpanContext.SpanID() || span.SpanContext.TraceID() != call.SpanContext.TraceID() {
+			t.Errorf("request span is not a child of tool call span: %s", span.Name)
+		}
+
+		if span.Status.Code != codes.Unset {
+			t.Errorf("unexpected status: %v", span.Status)
+		}
+	}
+
+	header := fake.LastRequest().Header.Get("traceparent")
+	if header == "" || header[3:35] != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("traceparent is not propagated to GitLab: %q", header)
+	}
+}
+
+func TestTracingError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	fake, _ := newFakeProject(t)
+	c, ctx := newTestClient(t, fake, true, server.WithToolHandlerMiddleware(gitlab.TracingMiddleware))
+
+	callTool(ctx, t, c, "get_pjs_id", map[string]any{"id": "group/missing"})
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("unexpected spans: %v", spans)
+	}
+
+	for _, span := range spans {
+		if span.Status.Code != codes.Error {
+			t.Errorf("%s: unexpected status: %v", span.Name, span.Status)
+		}
+	}
+
+	if spans[1].Parent.IsValid() {
+		t.Errorf("unexpected parent: %v", spans[1].Parent)
+	}
+}
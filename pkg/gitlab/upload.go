@@ -0,0 +1,193 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxUploadBytes is the maximum size of a file to upload.
+var maxUploadBytes int64 = 100 * 1024 * 1024
+
+var fileContentType = reflect.TypeFor[FileContent]()
+
+// FileContent is a file uploaded in a multipart/form-data request.
+// Either Content or Path is specified.
+type FileContent struct {
+	Content  string `json:"content,omitempty" jsonschema:"description=The file content encoded in base64."`
+	Path     string `json:"path,omitempty" jsonschema:"description=The local file path. The file must be in the upload directory of the server."`
+	Filename string `json:"filename,omitempty" jsonschema:"description=The file name sent to GitLab. Default is the base name of path. Required for content."`
+}
+
+// uploadFile resolves the local file path in the upload directory.
+// Symbolic links are resolved before checking that the file is in the directory.
+func uploadFile(ctx context.Context, name string) (string, error) {
+	dir, ok := ctx.Value(UploadDirKey{}).(string)
+	if !ok || dir == "" {
+		return "", fmt.Errorf("uploading a local file is disabled; specify the upload directory")
+	}
+
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", err
+	}
+
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(root, name)
+	}
+
+	resolved, err := filepath.EvalSymlinks(name)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: not in the upload directory", name)
+	}
+
+	return resolved, nil
+}
+
+// read returns the file name and the content to upload.
+func (f FileContent) read(ctx context.Context) (name string, content []byte, err error) {
+	switch {
+	case f.Content != "" && f.Path != "":
+		return "", nil, fmt.Errorf("specify only one of content or path")
+	case f.Content != "":
+		if f.Filename == "" {
+			return "", nil, fmt.Errorf("filename is required for content")
+		}
+
+		// The padding is not decoded.
+		padding := len(f.Content) - len(strings.TrimRight(f.Content, "="))
+		if int64(base64.StdEncoding.DecodedLen(len(f.Content))-padding) > maxUploadBytes {
+			return "", nil, fmt.Errorf("%s: exceeds %d bytes", f.Filename, maxUploadBytes)
+		}
+
+		content, err = base64.StdEncoding.DecodeString(f.Content)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return f.Filename, content, nil
+	case f.Path != "":
+		path, err := uploadFile(ctx, f.Path)
+		if err != nil {
+			return "", nil, err
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if !info.Mode().IsRegular() {
+			return "", nil, fmt.Errorf("%s: not a regular file", f.Path)
+		}
+
+		if info.Size() > maxUploadBytes {
+			return "", nil, fmt.Errorf("%s: exceeds %d bytes", f.Path, maxUploadBytes)
+		}
+
+		content, err = os.ReadFile(path)
+		if err != nil {
+			return "", nil, err
+		}
+
+		name = f.Filename
+		if name == "" {
+			name = filepath.Base(path)
+		}
+
+		return name, content, nil
+	default:
+		return "", nil, fmt.Errorf("specify content or path")
+	}
+}
+
+// multipartBody encodes the form struct as multipart/form-data.
+// The fields are named by the json tags, FileContent fields are sent as files
+// and empty fields with omitempty are not sent.
+func multipartBody(ctx context.Context, form any) (contentType string, body io.Reader, err error) {
+	buffer := &bytes.Buffer{}
+	writer := multipart.NewWriter(buffer)
+
+	value := reflect.Indirect(reflect.ValueOf(form))
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if fieldValue.IsZero() && (strings.Contains(options, "omitempty") || fieldValue.Kind() == reflect.Pointer) {
+			continue
+		}
+
+		fieldValue = reflect.Indirect(fieldValue)
+		if fieldValue.Type() == fileContentType {
+			if err := writeFilePart(ctx, writer, name, fieldValue.Interface().(FileContent)); err != nil {
+				return "", nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			continue
+		}
+
+		if err := writeFieldPart(writer, name, fieldValue); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), buffer, nil
+}
+
+func writeFilePart(ctx context.Context, writer *multipart.Writer, field string, file FileContent) error {
+	name, content, err := file.read(ctx)
+	if err != nil {
+		return err
+	}
+
+	part, err := writer.CreateFormFile(field, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = part.Write(content)
+	return err
+}
+
+func writeFieldPart(writer *multipart.Writer, name string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			if err := writeFieldPart(writer, name+"[]", value.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case reflect.Bool:
+		return writer.WriteField(name, strconv.FormatBool(value.Bool()))
+	default:
+		return writer.WriteField(name, fmt.Sprint(value.Interface()))
+	}
+}
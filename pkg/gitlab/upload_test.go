@@ -0,0 +1,136 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/9506hqwy/gitlab-mcp-server/pkg/gitlab"
+)
+
+// newUploadDir creates the upload directory with 'a.txt', and 'secret.txt' next to the directory.
+func newUploadDir(t *testing.T) (dir string, secret string) {
+	t.Helper()
+
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir = filepath.Join(root, "upload")
+	secret = filepath.Join(root, "secret.txt")
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(secret, []byte("secret"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir, secret
+}
+
+func TestUploadFile(t *testing.T) {
+	dir, secret := newUploadDir(t)
+	ctx := context.WithValue(context.Background(), gitlab.UploadDirKey{}, dir)
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "a.txt", expected: filepath.Join(dir, "a.txt")},
+		{name: filepath.Join(dir, "a.txt"), expected: filepath.Join(dir, "a.txt")},
+		{name: filepath.Join("sub", "..", "a.txt"), expected: filepath.Join(dir, "a.txt")},
+		{name: filepath.Join("..", "secret.txt")},
+		{name: filepath.Join("sub", "..", "..", "secret.txt")},
+		{name: secret},
+	}
+
+	for _, tt := range tests {
+		path, err := gitlab.UploadFile(ctx, tt.name)
+		if tt.expected == "" {
+			if err == nil || !strings.Contains(err.Error(), "not in the upload directory") {
+				t.Errorf("%s: got %q, %v", tt.name, path, err)
+			}
+
+			continue
+		}
+
+		if err != nil || path != tt.expected {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, path, err, tt.expected)
+		}
+	}
+}
+
+func TestUploadFileSymlink(t *testing.T) {
+	dir, secret := newUploadDir(t)
+	ctx := context.WithValue(context.Background(), gitlab.UploadDirKey{}, dir)
+
+	if err := os.Symlink(secret, filepath.Join(dir, "link.txt")); err != nil {
+		t.Skipf("symbolic link is not supported: %v", err)
+	}
+
+	if err := os.Symlink(filepath.Join(dir, "a.txt"), filepath.Join(dir, "sub", "inside.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	if path, err := gitlab.UploadFile(ctx, "link.txt"); err == nil || !strings.Contains(err.Error(), "not in the upload directory") {
+		t.Errorf("link.txt: got %q, %v", path, err)
+	}
+
+	if path, err := gitlab.UploadFile(ctx, filepath.Join("sub", "inside.txt")); err != nil || path != filepath.Join(dir, "a.txt") {
+		t.Errorf("sub/inside.txt: got %q, %v", path, err)
+	}
+}
+
+func TestUploadFileDisabled(t *testing.T) {
+	_, err := gitlab.UploadFile(context.Background(), "a.txt")
+	if err == nil || !strings.Contains(err.Error(), "uploading a local file is disabled") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFileContentLimit(t *testing.T) {
+	dir, _ := newUploadDir(t)
+	ctx := context.WithValue(context.Background(), gitlab.UploadDirKey{}, dir)
+
+	maxBytes := *gitlab.MaxUploadBytes
+	t.Cleanup(func() { *gitlab.MaxUploadBytes = maxBytes })
+	*gitlab.MaxUploadBytes = 8
+
+	tests := []struct {
+		file     gitlab.FileContent
+		exceeded bool
+	}{
+		{file: gitlab.FileContent{Content: base64.StdEncoding.EncodeToString([]byte("12345678")), Filename: "a.bin"}},
+		{file: gitlab.FileContent{Content: base64.StdEncoding.EncodeToString([]byte("123456789")), Filename: "a.bin"}, exceeded: true},
+		{file: gitlab.FileContent{Path: "a.txt"}},
+	}
+
+	for _, tt := range tests {
+		_, content, err := gitlab.ReadFileContent(tt.file, ctx)
+		if tt.exceeded {
+			if err == nil || !strings.Contains(err.Error(), "exceeds 8 bytes") {
+				t.Errorf("%+v: got %q, %v", tt.file, content, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", tt.file, err)
+		}
+	}
+
+	*gitlab.MaxUploadBytes = 0
+	if _, _, err := gitlab.ReadFileContent(gitlab.FileContent{Path: "a.txt"}, ctx); err == nil || !strings.Contains(err.Error(), "exceeds 0 bytes") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
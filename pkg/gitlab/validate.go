@@ -0,0 +1,126 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	validator "github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// constrain merges the constraints of the OpenAPI specification, such as enum and default,
+// into the properties of the field such as 'params'. These are lost when the schema is
+// reflected from the types of gitlab-client-go.
+func constrain(schema *jsonschema.Schema, field string, constraints map[string]*jsonschema.Schema) {
+	target, ok := schema.Properties.Get(field)
+	if !ok || target.Properties == nil {
+		return
+	}
+
+	for name, constraint := range constraints {
+		if property, ok := target.Properties.Get(name); ok {
+			mergeConstraint(property, constraint)
+		}
+	}
+}
+
+func mergeConstraint(target *jsonschema.Schema, constraint *jsonschema.Schema) {
+	if constraint.Enum != nil {
+		target.Enum = constraint.Enum
+	}
+
+	if constraint.Default != nil {
+		target.Default = constraint.Default
+	}
+
+	if constraint.Minimum != "" {
+		target.Minimum = constraint.Minimum
+	}
+
+	if constraint.Maximum != "" {
+		target.Maximum = constraint.Maximum
+	}
+
+	if constraint.Format != "" {
+		target.Format = constraint.Format
+	}
+
+	if constraint.Items != nil && target.Items != nil {
+		mergeConstraint(target.Items, constraint.Items)
+	}
+}
+
+// validateArguments returns the handler to validate the arguments against the input schema
+// before calling the handler, so that the invalid arguments are reported precisely instead
+// of being sent to GitLab. The schema is compiled at the first call.
+func validateArguments(schema json.RawMessage, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	compile := sync.OnceValues(func() (*validator.Schema, error) {
+		doc, err := validator.UnmarshalJSON(bytes.NewReader(schema))
+		if err != nil {
+			return nil, err
+		}
+
+		compiler := validator.NewCompiler()
+		if err := compiler.AddResource("schema.json", doc); err != nil {
+			return nil, err
+		}
+
+		return compiler.Compile("schema.json")
+	})
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		compiled, err := compile()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if err := validate(compiled, request.GetArguments()); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		return handler(ctx, request)
+	}
+}
+
+// validate returns the error listing each invalid argument with its location such as
+// "invalid arguments: at '/params/state': value must be one of 'opened', 'closed'".
+func validate(schema *validator.Schema, arguments map[string]any) error {
+	if arguments == nil {
+		arguments = map[string]any{}
+	}
+
+	content, err := json.Marshal(arguments)
+	if err != nil {
+		return err
+	}
+
+	doc, err := validator.UnmarshalJSON(bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = schema.Validate(doc)
+
+	var validationErr *validator.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	messages := []string{}
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("at '%s': %s", unit.InstanceLocation, unit.Error))
+	}
+
+	return fmt.Errorf("invalid arguments: %s", strings.Join(messages, "; "))
+}
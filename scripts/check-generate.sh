@@ -0,0 +1,17 @@
+#!/bin/bash
+set -euo pipefail
+
+SCRIPTS_PATH=$(dirname "$(readlink -f "$0")")
+BASE_PATH=$(dirname "${SCRIPTS_PATH}")
+
+pushd "${BASE_PATH}" > /dev/null
+    go generate ./pkg/gitlab
+
+    # The committed tools must be same as the tools generated from the pinned specification.
+    if [ -n "$(git status --porcelain -- pkg/gitlab)" ]; then
+        git status --short -- pkg/gitlab
+        git --no-pager diff --stat -- pkg/gitlab
+        echo "The generated tools differ. Run 'go generate ./pkg/gitlab' and commit the changes." >&2
+        exit 1
+    fi
+popd > /dev/null
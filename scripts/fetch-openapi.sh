@@ -0,0 +1,16 @@
+#!/bin/bash
+set -euo pipefail
+
+# The GitLab version of the OpenAPI specification to generate the tools from.
+GITLAB_VERSION="${GITLAB_VERSION:-v18.1.0-ee}"
+OUTPUT_PATH="$1"
+SPEC_URL="https://gitlab.com/gitlab-org/gitlab/-/raw/${GITLAB_VERSION}/doc/api/openapi/openapi_v2.yaml"
+
+TMP_PATH=$(mktemp --suffix=.yaml)
+trap 'rm -f "${TMP_PATH}"' EXIT
+
+curl --fail --silent --show-error --location --retry 3 -o "${TMP_PATH}" "${SPEC_URL}"
+
+# The specification is Swagger 2.0, and the generator reads OpenAPI 3.0.
+mkdir -p "$(dirname "${OUTPUT_PATH}")"
+npx --yes swagger2openapi@7 --yaml --outfile "${OUTPUT_PATH}" "${TMP_PATH}"